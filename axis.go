@@ -0,0 +1,185 @@
+package compactnumber
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/nkall/compactnumber/internal/compact"
+	"golang.org/x/text/number"
+)
+
+// maxAxisFractionDigits is the greatest number of fraction digits AxisTicks shows to tell labels apart.
+const maxAxisFractionDigits = 3
+
+// The range of int, which is 32 or 64 bits wide depending on the platform.
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
+
+// Tick is a tick of a chart axis, along with its compact label.
+type Tick struct {
+	Value int
+	Label string
+}
+
+// AxisTicks computes "nice" ticks for an axis spanning min to max, spaced by 1, 2 or 5 times a power of ten and as
+// close to count ticks as possible, and labels them in compact form. Unlike Format, labels are rounded rather than
+// truncated, with the fewest fraction digits that keep them unique, e.g. "1K", "1.5K" and "2K" in English. Ticks above
+// the cap of Cap are all labelled with the cap, e.g. "99K+", truncated as in Format.
+//
+// The ticks cover the whole span, so the first may be below min and the last above max, except where that would be
+// beyond the range of int.
+func (f *Formatter) AxisTicks(min, max int, count int, numOptions ...number.Option) ([]Tick, error) {
+	if count < 2 {
+		return nil, errors.New(fmt.Sprintf("invalid tick count %d (expected at least 2)", count))
+	}
+	if min > max {
+		return nil, errors.New(fmt.Sprintf("invalid axis span %d to %d", min, max))
+	}
+
+	f, compactForms, err := f.compactForms()
+	if err != nil {
+		return nil, err
+	}
+
+	values := niceTicks(min, max, count)
+
+	var labels []string
+	for fractionDigits := 0; fractionDigits <= maxAxisFractionDigits; fractionDigits++ {
+		tickOptions := append(numOptions[:len(numOptions):len(numOptions)], number.MaxFractionDigits(fractionDigits))
+
+		// Ticks above the cap are all labelled with it, so only the others are told apart
+		labels = make([]string, 0, len(values))
+		var uncappedLabels []string
+		for _, value := range values {
+			abs := value
+			if abs < 0 {
+				abs = -abs
+			}
+
+			// Ticks that would not be compacted by Format are formatted in full
+			var label string
+			rule := compact.SelectRule(compactForms[f.compactType], int64(abs))
+			if rule.Type == 0 || abs < f.threshold {
+				label, err = f.format(value, 0, numOptions)
+			} else {
+				label, err = f.format(value, rule.Type, tickOptions)
+			}
+			if err != nil {
+				return nil, err
+			}
+			labels = append(labels, label)
+			if !f.capped || value <= f.max {
+				uncappedLabels = append(uncappedLabels, label)
+			}
+		}
+
+		if unique(uncappedLabels) {
+			break
+		}
+	}
+
+	ticks := make([]Tick, 0, len(values))
+	for i, value := range values {
+		ticks = append(ticks, Tick{Value: value, Label: labels[i]})
+	}
+
+	return ticks, nil
+}
+
+// Computes the ticks of the span with Heckbert's nice numbers algorithm, from Graphics Gems (1990). Ticks beyond the
+// range of int are left out, so a span reaching its ends is not covered past the last tick within it.
+func niceTicks(min, max int, count int) []int {
+	if min == max {
+		return []int{min}
+	}
+
+	// The difference of the ends may not fit in an int, but it always fits in a uint
+	span := niceNum(float64(uint(max)-uint(min)), false)
+	step := int(niceStep(span / float64(count-1)))
+
+	// Rounding the span outwards to whole steps may go beyond the range of int
+	firstStep := floorDiv(min, step)
+	if firstStep < minInt/step {
+		firstStep++
+	}
+	lastStep := -floorDiv(-max, step)
+	if lastStep > maxInt/step {
+		lastStep--
+	}
+
+	ticks := make([]int, 0, lastStep-firstStep+1)
+	for i := 0; i <= lastStep-firstStep; i++ {
+		ticks = append(ticks, (firstStep+i)*step)
+	}
+
+	return ticks
+}
+
+// Finds the step between ticks closest to x: a number of 1, 2 or 5 times a power of ten, from 1 to the greatest such
+// number that fits in an int.
+func niceStep(x float64) float64 {
+	step := niceNum(x, true)
+	if step < 1 {
+		return 1
+	}
+
+	limit := float64(maxInt)
+	if step <= limit {
+		return step
+	}
+
+	power := math.Pow10(int(math.Floor(math.Log10(limit))))
+	for _, fraction := range []float64{5, 2, 1} {
+		if fraction*power <= limit {
+			return fraction * power
+		}
+	}
+	return power
+}
+
+// Divides a by a positive b, rounding towards negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+
+	return q
+}
+
+// Finds a number of 1, 2 or 5 times a power of ten close to x, rounding it if round is set and taking the ceiling
+// otherwise.
+func niceNum(x float64, round bool) float64 {
+	exponent := math.Floor(math.Log10(x))
+	fraction := x / math.Pow10(int(exponent))
+
+	var niceFraction float64
+	switch {
+	case round && fraction < 1.5, !round && fraction <= 1:
+		niceFraction = 1
+	case round && fraction < 3, !round && fraction <= 2:
+		niceFraction = 2
+	case round && fraction < 7, !round && fraction <= 5:
+		niceFraction = 5
+	default:
+		niceFraction = 10
+	}
+
+	return niceFraction * math.Pow10(int(exponent))
+}
+
+// Reports whether every label is different from the others.
+func unique(labels []string) bool {
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		if seen[label] {
+			return false
+		}
+		seen[label] = true
+	}
+
+	return true
+}
@@ -0,0 +1,172 @@
+package compactnumber_test
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+)
+
+func TestFormatterAxisTicks(t *testing.T) {
+	tests := []struct {
+		localeStr   string
+		compactType compactnumber.CompactType
+		min         int
+		max         int
+		count       int
+		options     []compactnumber.FormatterOption
+		expected    []compactnumber.Tick
+	}{
+		{
+			localeStr:   "en-US",
+			compactType: compactnumber.Short,
+			min:         1000,
+			max:         2000,
+			count:       3,
+			expected:    []compactnumber.Tick{{1000, "1K"}, {1500, "1.5K"}, {2000, "2K"}},
+		},
+		{
+			localeStr:   "en-US",
+			compactType: compactnumber.Short,
+			min:         -3000,
+			max:         7000,
+			count:       6,
+			expected:    []compactnumber.Tick{{-4000, "-4K"}, {-2000, "-2K"}, {0, "0"}, {2000, "2K"}, {4000, "4K"}, {6000, "6K"}, {8000, "8K"}},
+		},
+		{
+			localeStr:   "en-US",
+			compactType: compactnumber.Long,
+			min:         1000,
+			max:         1003,
+			count:       4,
+			expected:    []compactnumber.Tick{{1000, "1 thousand"}, {1002, "1.002 thousand"}, {1004, "1.004 thousand"}},
+		},
+		{
+			localeStr:   "ja",
+			compactType: compactnumber.Short,
+			min:         0,
+			max:         123456,
+			count:       5,
+			expected:    []compactnumber.Tick{{0, "0"}, {50000, "5万"}, {100000, "10万"}, {150000, "15万"}},
+		},
+		// Ticks the locale doesn't compact are formatted in full
+		{
+			localeStr:   "de",
+			compactType: compactnumber.Short,
+			min:         0,
+			max:         2000,
+			count:       3,
+			expected:    []compactnumber.Tick{{0, "0"}, {1000, "1.000"}, {2000, "2.000"}},
+		},
+		{
+			localeStr:   "en-US",
+			compactType: compactnumber.Short,
+			min:         5,
+			max:         5,
+			count:       4,
+			expected:    []compactnumber.Tick{{5, "5"}},
+		},
+		// Ticks above the cap are all labelled with it
+		{
+			localeStr:   "en-US",
+			compactType: compactnumber.Short,
+			min:         0,
+			max:         200000,
+			count:       5,
+			options:     []compactnumber.FormatterOption{compactnumber.Cap(99999)},
+			expected:    []compactnumber.Tick{{0, "0"}, {50000, "50K"}, {100000, "99K+"}, {150000, "99K+"}, {200000, "99K+"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%d-%d", tt.localeStr, tt.compactType, tt.min, tt.max), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.localeStr, tt.compactType, tt.options...)
+			ticks, err := formatter.AxisTicks(tt.min, tt.max, tt.count)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(ticks, tt.expected) {
+				t.Errorf("got unexpected ticks %v (wanted %v)", ticks, tt.expected)
+			}
+		})
+	}
+
+	formatter := compactnumber.NewFormatter("en-US", compactnumber.Short)
+	if _, err := formatter.AxisTicks(0, 1000, 1); err == nil {
+		t.Error("expected an error for a tick count below 2")
+	}
+	if _, err := formatter.AxisTicks(1000, 0, 5); err == nil {
+		t.Error("expected an error for an inverted span")
+	}
+}
+
+func TestFormatterAxisTicksIntRange(t *testing.T) {
+	// int is 32 or 64 bits wide depending on the platform, and so is the span of the axes
+	const maxInt = int(^uint(0) >> 1)
+	const minInt = -maxInt - 1
+
+	// Ticks are int64 so that those of 64-bit platforms compile on 32-bit ones
+	type tick struct {
+		value int64
+		label string
+	}
+	tests := []struct {
+		min        int
+		max        int
+		count      int
+		expected32 []tick
+		expected64 []tick
+	}{
+		// Ticks beyond the range of int are left out
+		{
+			min:        0,
+			max:        maxInt,
+			count:      5,
+			expected32: []tick{{0, "0"}, {1e9, "1B"}, {2e9, "2B"}},
+			expected64: []tick{{0, "0"}, {2e18, "2,000,000T"}, {4e18, "4,000,000T"}, {6e18, "6,000,000T"}, {8e18, "8,000,000T"}},
+		},
+		{
+			min:        minInt,
+			max:        maxInt,
+			count:      2,
+			expected32: []tick{{-2e9, "-2B"}, {0, "0"}, {2e9, "2B"}},
+			expected64: []tick{{-5e18, "-5,000,000T"}, {0, "0"}, {5e18, "5,000,000T"}},
+		},
+		{
+			min:        minInt,
+			max:        0,
+			count:      5,
+			expected32: []tick{{-2e9, "-2B"}, {-1e9, "-1B"}, {0, "0"}},
+			expected64: []tick{{-8e18, "-8,000,000T"}, {-6e18, "-6,000,000T"}, {-4e18, "-4,000,000T"}, {-2e18, "-2,000,000T"}, {0, "0"}},
+		},
+		{
+			min:        maxInt - 4,
+			max:        maxInt,
+			count:      3,
+			expected32: []tick{{2147483642, "2.147B"}, {2147483644, "2.147B"}, {2147483646, "2.147B"}},
+			expected64: []tick{{9223372036854775802, "9,223,372.037T"}, {9223372036854775804, "9,223,372.037T"}, {9223372036854775806, "9,223,372.037T"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d-%d", tt.min, tt.max), func(t *testing.T) {
+			expectedTicks := tt.expected64
+			if strconv.IntSize == 32 {
+				expectedTicks = tt.expected32
+			}
+			expected := make([]compactnumber.Tick, 0, len(expectedTicks))
+			for _, tick := range expectedTicks {
+				expected = append(expected, compactnumber.Tick{Value: int(tick.value), Label: tick.label})
+			}
+
+			formatter := compactnumber.NewFormatter("en-US", compactnumber.Short)
+			ticks, err := formatter.AxisTicks(tt.min, tt.max, tt.count)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(ticks, expected) {
+				t.Errorf("got unexpected ticks %v (wanted %v)", ticks, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/nkall/compactnumber"
+	"github.com/nkall/compactnumber/internal/models"
+)
+
+// bundleSchemaVersion is the version of the JSON data bundle format. It must be incremented whenever the format
+// changes in a way that existing clients cannot read.
+const bundleSchemaVersion = 2
+
+// bundle is the JSON data bundle shipped to clients that format compact numbers themselves. Rules use the JSON
+// encoding of compactnumber.Rule, and the other tables hold the same data as the tables of forms.gen.go.
+type bundle struct {
+	SchemaVersion         int                                                           `json:"schemaVersion"`
+	CLDRVersion           string                                                        `json:"cldrVersion"`
+	Coverage              string                                                        `json:"coverage"`
+	Locales               map[string]map[compactnumber.CompactType][]compactnumber.Rule `json:"locales"`
+	MiscPatterns          map[string]bundleMiscPatterns                                 `json:"miscPatterns"`
+	Signs                 map[string]bundleSigns                                        `json:"signs"`
+	MinimumGroupingDigits map[string]int                                                `json:"minimumGroupingDigits"`
+	PluralRanges          map[string]models.PluralRanges                                `json:"pluralRanges"`
+}
+
+// bundleMiscPatterns is the JSON encoding of models.MiscPatterns.
+type bundleMiscPatterns struct {
+	Approximately string `json:"approximately"`
+	AtLeast       string `json:"atLeast"`
+	AtMost        string `json:"atMost"`
+	Range         string `json:"range"`
+}
+
+// bundleSigns is the JSON encoding of models.Signs.
+type bundleSigns struct {
+	PlusSign         string `json:"plusSign"`
+	AccountingPrefix string `json:"accountingPrefix"`
+	AccountingSuffix string `json:"accountingSuffix"`
+}
+
+func newBundle(params generationParams) bundle {
+	b := bundle{
+		SchemaVersion: bundleSchemaVersion,
+		CLDRVersion:   params.CLDRVersion,
+		Coverage:      params.Coverage,
+		Locales:       make(map[string]map[compactnumber.CompactType][]compactnumber.Rule, len(params.CompactFormsByLanguage)),
+
+		MiscPatterns:          make(map[string]bundleMiscPatterns, len(params.MiscPatternsByLanguage)),
+		Signs:                 make(map[string]bundleSigns, len(params.SignsByLanguage)),
+		MinimumGroupingDigits: params.MinimumGroupingDigitsByLanguage,
+		PluralRanges:          params.PluralRangesByLanguage,
+	}
+	for locale, patterns := range params.MiscPatternsByLanguage {
+		b.MiscPatterns[locale] = bundleMiscPatterns(patterns)
+	}
+	for locale, signs := range params.SignsByLanguage {
+		b.Signs[locale] = bundleSigns(signs)
+	}
+
+	for locale, forms := range params.CompactFormsByLanguage {
+		b.Locales[locale] = make(map[compactnumber.CompactType][]compactnumber.Rule, len(forms))
+		for compactType, modelRules := range forms {
+			rules := make([]compactnumber.Rule, 0, len(modelRules))
+			for _, modelRule := range modelRules {
+				rule := compactnumber.Rule{
+					Type:            modelRule.Type,
+					ZeroesInPattern: modelRule.ZeroesInPattern,
+					Patterns:        make(map[compactnumber.PluralCategory]string, len(modelRule.PatternsByPluralForm)),
+				}
+				for pluralForm, pattern := range modelRule.PatternsByPluralForm {
+					rule.Patterns[compactnumber.PluralCategory(pluralForm)] = pattern
+				}
+				rules = append(rules, rule)
+			}
+			b.Locales[locale][compactType] = rules
+		}
+	}
+
+	return b
+}
+
+func (b bundle) toParams() generationParams {
+	params := generationParams{
+		CompactFormsByLanguage:          make(map[string]map[compactnumber.CompactType][]models.CompactFormRule, len(b.Locales)),
+		MiscPatternsByLanguage:          make(map[string]models.MiscPatterns, len(b.MiscPatterns)),
+		SignsByLanguage:                 make(map[string]models.Signs, len(b.Signs)),
+		MinimumGroupingDigitsByLanguage: b.MinimumGroupingDigits,
+		PluralRangesByLanguage:          b.PluralRanges,
+		CLDRVersion:                     b.CLDRVersion,
+		Coverage:                        b.Coverage,
+	}
+	for locale, patterns := range b.MiscPatterns {
+		params.MiscPatternsByLanguage[locale] = models.MiscPatterns(patterns)
+	}
+	for locale, signs := range b.Signs {
+		params.SignsByLanguage[locale] = models.Signs(signs)
+	}
+
+	for locale, forms := range b.Locales {
+		params.CompactFormsByLanguage[locale] = make(map[compactnumber.CompactType][]models.CompactFormRule, len(forms))
+		for compactType, rules := range forms {
+			modelRules := make([]models.CompactFormRule, 0, len(rules))
+			for _, rule := range rules {
+				modelRule := models.CompactFormRule{
+					Type:                 rule.Type,
+					ZeroesInPattern:      rule.ZeroesInPattern,
+					PatternsByPluralForm: make(map[string]string, len(rule.Patterns)),
+				}
+				for category, pattern := range rule.Patterns {
+					modelRule.PatternsByPluralForm[string(category)] = pattern
+				}
+				modelRules = append(modelRules, modelRule)
+			}
+			params.CompactFormsByLanguage[locale][compactType] = modelRules
+		}
+	}
+
+	return params
+}
+
+// marshalBundle encodes the bundle with sorted keys and without HTML escaping, so patterns stay readable.
+func marshalBundle(b bundle) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(b); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// loadBundle reads the tables back from a JSON data bundle.
+func loadBundle(path string) (generationParams, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return generationParams{}, err
+	}
+
+	var b bundle
+	if err := json.Unmarshal(content, &b); err != nil {
+		return generationParams{}, err
+	}
+	if b.SchemaVersion != bundleSchemaVersion {
+		return generationParams{}, errors.New(fmt.Sprintf("unsupported bundle schema version %d (expected %d)", b.SchemaVersion, bundleSchemaVersion))
+	}
+
+	return b.toParams(), nil
+}
+
+// renderBundleFiles renders the JSON data bundle and the TypeScript module embedding it, skipping the ones without an
+// output path.
+func renderBundleFiles(params generationParams, opts generateOptions) ([]generatedFile, error) {
+	bundleJSON, err := marshalBundle(newBundle(params))
+	if err != nil {
+		return nil, err
+	}
+
+	var files []generatedFile
+	if opts.jsonOutPath != "" {
+		files = append(files, generatedFile{path: opts.jsonOutPath, content: bundleJSON})
+	}
+
+	if opts.tsOutPath != "" {
+		content, err := executeTemplate(struct {
+			generationParams
+			SchemaVersion int
+			BundleJSON    string
+		}{
+			generationParams: params,
+			SchemaVersion:    bundleSchemaVersion,
+			BundleJSON:       string(bytes.TrimSpace(bundleJSON)),
+		}, filepath.Join(opts.templateDir, "forms.ts.tmpl"))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, generatedFile{path: opts.tsOutPath, content: content})
+	}
+
+	return files, nil
+}
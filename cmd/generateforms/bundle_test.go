@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBundleRoundTrip(t *testing.T) {
+	params, err := loadGeneratedFile("../../forms.gen.go")
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+	for name, size := range map[string]int{
+		"compact forms":           len(params.CompactFormsByLanguage),
+		"misc patterns":           len(params.MiscPatternsByLanguage),
+		"signs":                   len(params.SignsByLanguage),
+		"minimum grouping digits": len(params.MinimumGroupingDigitsByLanguage),
+		"plural ranges":           len(params.PluralRangesByLanguage),
+	} {
+		if size == 0 {
+			t.Errorf("got no %s from forms.gen.go", name)
+		}
+	}
+
+	// The checked-in bundle holds the same data as forms.gen.go
+	bundleJSON, err := marshalBundle(newBundle(params))
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+	checkedIn, err := ioutil.ReadFile("../../forms.gen.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(bundleJSON, checkedIn) {
+		t.Error("forms.gen.json does not match forms.gen.go")
+	}
+
+	loaded, err := loadBundle("../../forms.gen.json")
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(loaded, params) {
+		t.Error("tables loaded from forms.gen.json differ from forms.gen.go")
+	}
+}
+
+func TestLoadBundleSchemaVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generateforms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "forms.gen.json")
+	if err := ioutil.WriteFile(path, []byte(`{"schemaVersion": 1, "locales": {}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = loadBundle(path)
+	if err == nil || !strings.Contains(err.Error(), "unsupported bundle schema version 1") {
+		t.Errorf("got unexpected error %v", err)
+	}
+}
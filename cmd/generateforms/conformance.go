@@ -0,0 +1,73 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/nkall/compactnumber"
+	"github.com/nkall/compactnumber/internal/compact"
+	"github.com/nkall/compactnumber/internal/models"
+	"golang.org/x/text/language"
+)
+
+// conformanceCorpus is a set of expected outputs captured offline from another CLDR implementation, such as ICU.
+// Cases that are known to differ from this package carry the key of the reason in Differences.
+type conformanceCorpus struct {
+	Source      string            `json:"source"`
+	Differences map[string]string `json:"differences"`
+	Cases       []conformanceCase `json:"cases"`
+}
+
+// conformanceCase is the expected output of formatting N in a locale. Type and PluralForm identify the rule and
+// plural form the case covers.
+type conformanceCase struct {
+	Locale      string                    `json:"locale"`
+	CompactType compactnumber.CompactType `json:"compactType"`
+	Type        int64                     `json:"type"`
+	PluralForm  string                    `json:"pluralForm"`
+	N           int64                     `json:"n"`
+	Expected    string                    `json:"expected"`
+	Difference  string                    `json:"difference,omitempty"`
+}
+
+// loadConformanceCorpus reads the corpus at path and keeps the cases of the locales whose data is generated, under
+// their own key or one Format falls back to, e.g. sr-Cyrl-BA for sr-BA.
+func loadConformanceCorpus(path string, compactFormsByLanguage map[string]map[compactnumber.CompactType][]models.CompactFormRule) (conformanceCorpus, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return conformanceCorpus{}, err
+	}
+
+	var corpus conformanceCorpus
+	err = json.Unmarshal(b, &corpus)
+	if err != nil {
+		return conformanceCorpus{}, errors.New(fmt.Sprintf("error parsing conformance corpus: %s", err.Error()))
+	}
+
+	cases := corpus.Cases[:0]
+	for _, c := range corpus.Cases {
+		if !hasData(compactFormsByLanguage, c.Locale) {
+			continue
+		}
+		if _, ok := corpus.Differences[c.Difference]; c.Difference != "" && !ok {
+			return conformanceCorpus{}, errors.New(fmt.Sprintf("conformance case %s %s %d has unknown difference %q", c.Locale, c.CompactType, c.N, c.Difference))
+		}
+		cases = append(cases, c)
+	}
+	corpus.Cases = cases
+
+	return corpus, nil
+}
+
+// hasData reports whether Format finds compact forms for locale among compactFormsByLanguage.
+func hasData(compactFormsByLanguage map[string]map[compactnumber.CompactType][]models.CompactFormRule, locale string) bool {
+	for _, key := range compact.DataKeys(language.Make(locale)) {
+		if _, ok := compactFormsByLanguage[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
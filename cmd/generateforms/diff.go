@@ -0,0 +1,658 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nkall/compactnumber"
+	"github.com/nkall/compactnumber/internal/compact"
+	"github.com/nkall/compactnumber/internal/models"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// diffOptions holds the flags and arguments of the diff command.
+type diffOptions struct {
+	coverage  string
+	keepGoing bool
+	samples   sampleList
+	oldPath   string
+	newPath   string
+}
+
+// sampleList is a comma-separated list of numbers rendered with both data sets.
+type sampleList []int64
+
+func (l *sampleList) String() string {
+	strs := make([]string, 0, len(*l))
+	for _, n := range *l {
+		strs = append(strs, strconv.FormatInt(n, 10))
+	}
+	return strings.Join(strs, ",")
+}
+
+func (l *sampleList) Set(s string) error {
+	for _, str := range strings.Split(s, ",") {
+		n, err := strconv.ParseInt(strings.TrimSpace(str), 10, 64)
+		if err != nil {
+			return err
+		}
+		*l = append(*l, n)
+	}
+	return nil
+}
+
+func parseDiffOptions(args []string) (diffOptions, error) {
+	var opts diffOptions
+	fs := flag.NewFlagSet("generateforms diff", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: generateforms diff [flags] OLD NEW")
+		fmt.Fprintln(fs.Output(), "OLD and NEW are CLDR JSON distributions, generated forms.gen.go files or JSON data bundles.")
+		fs.PrintDefaults()
+	}
+	fs.StringVar(&opts.coverage, "coverage", "modern", "CLDR coverage level to read from distributions (modern or full)")
+	fs.BoolVar(&opts.keepGoing, "keep-going", false, "skip locales that fail to extract instead of failing the run")
+	fs.Var(&opts.samples, "samples", "comma-separated numbers to render (default 1, 2 and 5 times every compact type)")
+	if err := fs.Parse(args); err != nil {
+		return diffOptions{}, err
+	}
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return diffOptions{}, errors.New("expected exactly two data sets to compare")
+	}
+	opts.oldPath, opts.newPath = fs.Arg(0), fs.Arg(1)
+
+	return opts, nil
+}
+
+// loadDataSet loads compact forms from a CLDR JSON distribution, a JSON data bundle or a generated Go file.
+func loadDataSet(path string, opts diffOptions) (generationParams, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return generationParams{}, err
+	}
+
+	switch {
+	case info.IsDir():
+		return loadCompactForms(generateOptions{cldrPath: path, coverage: opts.coverage, keepGoing: opts.keepGoing})
+	case filepath.Ext(path) == ".json":
+		return loadBundle(path)
+	default:
+		return loadGeneratedFile(path)
+	}
+}
+
+var generatedHeaderPattern = regexp.MustCompile(`cldr-numbers-(\w+) version (\S+)`)
+
+// loadGeneratedFile reads the tables back from a file generated from forms.tmpl. Only compactFormsByLanguage is
+// required, as files generated by older versions of the generator lack the other tables.
+func loadGeneratedFile(path string) (generationParams, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return generationParams{}, err
+	}
+
+	params := generationParams{
+		CompactFormsByLanguage:          make(map[string]map[compactnumber.CompactType][]models.CompactFormRule),
+		MiscPatternsByLanguage:          make(map[string]models.MiscPatterns),
+		SignsByLanguage:                 make(map[string]models.Signs),
+		MinimumGroupingDigitsByLanguage: make(map[string]int),
+		PluralRangesByLanguage:          make(map[string]models.PluralRanges),
+	}
+	if len(file.Comments) > 0 {
+		if match := generatedHeaderPattern.FindStringSubmatch(file.Comments[0].Text()); match != nil {
+			params.Coverage, params.CLDRVersion = match[1], match[2]
+		}
+	}
+
+	tables := []struct {
+		name     string
+		required bool
+		load     func(lang string, value ast.Expr) error
+	}{
+		{name: "compactFormsByLanguage", required: true, load: func(lang string, value ast.Expr) error {
+			forms, err := compactFormsLit(value)
+			params.CompactFormsByLanguage[lang] = forms
+			return err
+		}},
+		{name: "miscPatternsByLanguage", load: func(lang string, value ast.Expr) error {
+			fields, err := stringMapLit(value)
+			params.MiscPatternsByLanguage[lang] = models.MiscPatterns{
+				Approximately: fields["Approximately"],
+				AtLeast:       fields["AtLeast"],
+				AtMost:        fields["AtMost"],
+				Range:         fields["Range"],
+			}
+			return err
+		}},
+		{name: "signsByLanguage", load: func(lang string, value ast.Expr) error {
+			fields, err := stringMapLit(value)
+			params.SignsByLanguage[lang] = models.Signs{
+				PlusSign:         fields["PlusSign"],
+				AccountingPrefix: fields["AccountingPrefix"],
+				AccountingSuffix: fields["AccountingSuffix"],
+			}
+			return err
+		}},
+		{name: "minimumGroupingDigitsByLanguage", load: func(lang string, value ast.Expr) error {
+			digits, err := intLit(value)
+			params.MinimumGroupingDigitsByLanguage[lang] = int(digits)
+			return err
+		}},
+		{name: "pluralRangesByLanguage", load: func(lang string, value ast.Expr) error {
+			pluralRanges, err := pluralRangesLit(value)
+			params.PluralRangesByLanguage[lang] = pluralRanges
+			return err
+		}},
+	}
+	for _, table := range tables {
+		lit := tableLit(file, table.name)
+		if lit == nil && table.required {
+			return generationParams{}, errors.New(fmt.Sprintf("no %s table in %s", table.name, path))
+		} else if lit == nil {
+			continue
+		}
+
+		for _, langElt := range lit.Elts {
+			langKV, ok := langElt.(*ast.KeyValueExpr)
+			if !ok {
+				return generationParams{}, errors.New(fmt.Sprintf("unexpected element at %s", fset.Position(langElt.Pos())))
+			}
+			lang, err := stringLit(langKV.Key)
+			if err != nil {
+				return generationParams{}, errors.New(fmt.Sprintf("%s: %s", fset.Position(langKV.Pos()), err.Error()))
+			}
+
+			if err := table.load(lang, langKV.Value); err != nil {
+				return generationParams{}, errors.New(fmt.Sprintf("%s: %s", fset.Position(langKV.Pos()), err.Error()))
+			}
+		}
+	}
+
+	return params, nil
+}
+
+// tableLit finds the composite literal the package-level variable of a generated file is initialized with, or nil if
+// there is none.
+func tableLit(file *ast.File, name string) *ast.CompositeLit {
+	var table *ast.CompositeLit
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || spec.Names[0].Name != name || len(spec.Values) != 1 {
+			return table == nil
+		}
+		table, _ = spec.Values[0].(*ast.CompositeLit)
+		return false
+	})
+
+	return table
+}
+
+func compactFormsLit(expr ast.Expr) (map[compactnumber.CompactType][]models.CompactFormRule, error) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil, errors.New("compact forms are not a composite literal")
+	}
+
+	forms := make(map[compactnumber.CompactType][]models.CompactFormRule)
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, errors.New("compact forms element is not a key-value pair")
+		}
+		compactType, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			return nil, errors.New("compact type is not an identifier")
+		}
+		rulesLit, ok := kv.Value.(*ast.CompositeLit)
+		if !ok {
+			return nil, errors.New("compact form rules are not a composite literal")
+		}
+
+		rules := make([]models.CompactFormRule, 0, len(rulesLit.Elts))
+		for _, ruleElt := range rulesLit.Elts {
+			rule, err := compactFormRuleLit(ruleElt)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+		}
+		forms[compactnumber.CompactType(compactType.Name)] = rules
+	}
+
+	return forms, nil
+}
+
+func compactFormRuleLit(expr ast.Expr) (models.CompactFormRule, error) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return models.CompactFormRule{}, errors.New("compact form rule is not a composite literal")
+	}
+
+	rule := models.CompactFormRule{PatternsByPluralForm: make(map[string]string)}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return models.CompactFormRule{}, errors.New("compact form rule field is not a key-value pair")
+		}
+		field, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			return models.CompactFormRule{}, errors.New("compact form rule field name is not an identifier")
+		}
+
+		var err error
+		switch field.Name {
+		case "Type":
+			rule.Type, err = intLit(kv.Value)
+		case "ZeroesInPattern":
+			var zeroes int64
+			zeroes, err = intLit(kv.Value)
+			rule.ZeroesInPattern = int(zeroes)
+		case "PatternsByPluralForm":
+			patternsLit, ok := kv.Value.(*ast.CompositeLit)
+			if !ok {
+				return models.CompactFormRule{}, errors.New("patterns are not a composite literal")
+			}
+			for _, patternElt := range patternsLit.Elts {
+				patternKV, ok := patternElt.(*ast.KeyValueExpr)
+				if !ok {
+					return models.CompactFormRule{}, errors.New("pattern is not a key-value pair")
+				}
+				var pluralForm, pattern string
+				if pluralForm, err = stringLit(patternKV.Key); err != nil {
+					break
+				}
+				if pattern, err = stringLit(patternKV.Value); err != nil {
+					break
+				}
+				rule.PatternsByPluralForm[pluralForm] = pattern
+			}
+		default:
+			err = errors.New(fmt.Sprintf("unknown compact form rule field %s", field.Name))
+		}
+		if err != nil {
+			return models.CompactFormRule{}, err
+		}
+	}
+
+	return rule, nil
+}
+
+func pluralRangesLit(expr ast.Expr) (models.PluralRanges, error) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil, errors.New("plural ranges are not a composite literal")
+	}
+
+	pluralRanges := make(models.PluralRanges)
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, errors.New("plural range is not a key-value pair")
+		}
+		start, err := stringLit(kv.Key)
+		if err != nil {
+			return nil, err
+		}
+		if pluralRanges[start], err = stringMapLit(kv.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	return pluralRanges, nil
+}
+
+// stringMapLit reads a map or struct literal whose values are strings, keyed by the map keys or the field names.
+func stringMapLit(expr ast.Expr) (map[string]string, error) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil, errors.New("expected a composite literal")
+	}
+
+	values := make(map[string]string, len(lit.Elts))
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return nil, errors.New("expected a key-value pair")
+		}
+
+		var key string
+		if field, ok := kv.Key.(*ast.Ident); ok {
+			key = field.Name
+		} else {
+			var err error
+			if key, err = stringLit(kv.Key); err != nil {
+				return nil, err
+			}
+		}
+
+		value, err := stringLit(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		values[key] = value
+	}
+
+	return values, nil
+}
+
+func stringLit(expr ast.Expr) (string, error) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", errors.New("expected a string literal")
+	}
+	return strconv.Unquote(lit.Value)
+}
+
+func intLit(expr ast.Expr) (int64, error) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return 0, errors.New("expected an integer literal")
+	}
+	return strconv.ParseInt(lit.Value, 0, 64)
+}
+
+type changeKind string
+
+const (
+	added   = changeKind("+")
+	removed = changeKind("-")
+	changed = changeKind("~")
+)
+
+// ruleChange is a difference in a single compact form rule. PluralForm is empty if the whole rule was added or
+// removed, or if its number of zeroes changed, in which case Old and New hold the numbers of zeroes.
+type ruleChange struct {
+	Kind       changeKind
+	Type       int64
+	PluralForm string
+	Old        string
+	New        string
+}
+
+// sampleChange is a sample number whose rendering differs between the data sets.
+type sampleChange struct {
+	N   int64
+	Old string
+	New string
+}
+
+type compactTypeDiff struct {
+	CompactType compactnumber.CompactType
+	Changes     []ruleChange
+	Samples     []sampleChange
+}
+
+type localeDiff struct {
+	Locale string
+	Types  []compactTypeDiff
+}
+
+type dataSetDiff struct {
+	Old            generationParams
+	New            generationParams
+	AddedLocales   []string
+	RemovedLocales []string
+	Locales        []localeDiff
+}
+
+func diffDataSets(oldSet generationParams, newSet generationParams, samples []int64) dataSetDiff {
+	diff := dataSetDiff{Old: oldSet, New: newSet}
+
+	for _, locale := range sortedLocales(oldSet.CompactFormsByLanguage, newSet.CompactFormsByLanguage) {
+		oldForms, inOld := oldSet.CompactFormsByLanguage[locale]
+		newForms, inNew := newSet.CompactFormsByLanguage[locale]
+		switch {
+		case !inOld:
+			diff.AddedLocales = append(diff.AddedLocales, locale)
+			continue
+		case !inNew:
+			diff.RemovedLocales = append(diff.RemovedLocales, locale)
+			continue
+		}
+
+		localeDiff := localeDiff{Locale: locale}
+		for _, compactType := range []compactnumber.CompactType{compactnumber.Short, compactnumber.Long} {
+			typeDiff := diffRules(oldForms[compactType], newForms[compactType])
+			typeDiff.CompactType = compactType
+
+			// Samples are rendered even if no pattern changed, as the output also depends on the zeroes of the
+			// rules and on which rule a number selects
+			tag := language.Make(locale)
+			for _, n := range samplesFor(samples, oldForms[compactType], newForms[compactType]) {
+				oldOut := renderSample(tag, oldForms[compactType], n)
+				newOut := renderSample(tag, newForms[compactType], n)
+				if oldOut != newOut {
+					typeDiff.Samples = append(typeDiff.Samples, sampleChange{N: n, Old: oldOut, New: newOut})
+				}
+			}
+			if len(typeDiff.Changes) == 0 && len(typeDiff.Samples) == 0 {
+				continue
+			}
+			localeDiff.Types = append(localeDiff.Types, typeDiff)
+		}
+		if len(localeDiff.Types) > 0 {
+			diff.Locales = append(diff.Locales, localeDiff)
+		}
+	}
+
+	return diff
+}
+
+func sortedLocales(a map[string]map[compactnumber.CompactType][]models.CompactFormRule, b map[string]map[compactnumber.CompactType][]models.CompactFormRule) []string {
+	locales := make([]string, 0, len(a))
+	for locale := range a {
+		locales = append(locales, locale)
+	}
+	for locale := range b {
+		if _, ok := a[locale]; !ok {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+func diffRules(oldRules []models.CompactFormRule, newRules []models.CompactFormRule) compactTypeDiff {
+	var diff compactTypeDiff
+	oldByType := rulesByType(oldRules)
+	newByType := rulesByType(newRules)
+
+	for _, ruleType := range sortedTypes(oldRules, newRules) {
+		oldRule, inOld := oldByType[ruleType]
+		newRule, inNew := newByType[ruleType]
+		switch {
+		case !inOld:
+			diff.Changes = append(diff.Changes, ruleChange{Kind: added, Type: ruleType})
+			continue
+		case !inNew:
+			diff.Changes = append(diff.Changes, ruleChange{Kind: removed, Type: ruleType})
+			continue
+		}
+
+		if oldRule.ZeroesInPattern != newRule.ZeroesInPattern {
+			diff.Changes = append(diff.Changes, ruleChange{
+				Kind: changed,
+				Type: ruleType,
+				Old:  strconv.Itoa(oldRule.ZeroesInPattern),
+				New:  strconv.Itoa(newRule.ZeroesInPattern),
+			})
+		}
+
+		for _, pluralForm := range sortedPluralForms(oldRule.PatternsByPluralForm, newRule.PatternsByPluralForm) {
+			oldPattern, inOld := oldRule.PatternsByPluralForm[pluralForm]
+			newPattern, inNew := newRule.PatternsByPluralForm[pluralForm]
+			change := ruleChange{Type: ruleType, PluralForm: pluralForm, Old: oldPattern, New: newPattern}
+			switch {
+			case !inOld:
+				change.Kind = added
+			case !inNew:
+				change.Kind = removed
+			case oldPattern != newPattern:
+				change.Kind = changed
+			default:
+				continue
+			}
+			diff.Changes = append(diff.Changes, change)
+		}
+	}
+
+	return diff
+}
+
+func rulesByType(rules []models.CompactFormRule) map[int64]models.CompactFormRule {
+	byType := make(map[int64]models.CompactFormRule, len(rules))
+	for _, rule := range rules {
+		byType[rule.Type] = rule
+	}
+	return byType
+}
+
+func sortedTypes(a []models.CompactFormRule, b []models.CompactFormRule) []int64 {
+	seen := make(map[int64]bool)
+	var types []int64
+	for _, rule := range append(append([]models.CompactFormRule{}, a...), b...) {
+		if !seen[rule.Type] {
+			seen[rule.Type] = true
+			types = append(types, rule.Type)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+func sortedPluralForms(a map[string]string, b map[string]string) []string {
+	forms := make([]string, 0, len(a))
+	for form := range a {
+		forms = append(forms, form)
+	}
+	for form := range b {
+		if _, ok := a[form]; !ok {
+			forms = append(forms, form)
+		}
+	}
+	sort.Strings(forms)
+	return forms
+}
+
+// samplesFor returns the requested samples, or 1, 2 and 5 times every type of either set of rules, which covers the
+// plural forms of most languages.
+func samplesFor(samples []int64, oldRules []models.CompactFormRule, newRules []models.CompactFormRule) []int64 {
+	if len(samples) > 0 {
+		return samples
+	}
+
+	var out []int64
+	for _, ruleType := range sortedTypes(oldRules, newRules) {
+		out = append(out, ruleType, 2*ruleType, 5*ruleType)
+	}
+	return out
+}
+
+// renderSample formats n with the given rules the same way Formatter.Format does with its default options.
+func renderSample(tag language.Tag, rules []models.CompactFormRule, n int64) string {
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+
+	rule := compact.SelectRule(rules, abs)
+	shortN := abs
+	if divisor := compact.Divisor(rule); divisor != 0 {
+		shortN /= divisor
+	}
+
+	pattern, ok := rule.PatternsByPluralForm[compact.PluralForm(tag, shortN)]
+	if !ok {
+		pattern = rule.PatternsByPluralForm["other"]
+	}
+	pattern, err := compact.SprintfPattern(pattern)
+	if err != nil {
+		return fmt.Sprintf("<%s>", err.Error())
+	}
+
+	if n < 0 {
+		shortN = -shortN
+	}
+	printer := message.NewPrinter(tag)
+	if pattern == "0" {
+		return printer.Sprintf("%v", number.Decimal(n, number.Scale(0)))
+	}
+	return printer.Sprintf(pattern, number.Decimal(shortN, number.Scale(0)))
+}
+
+func (d dataSetDiff) writeReport(w io.Writer) {
+	fmt.Fprintf(w, "Comparing CLDR %s (%s) with CLDR %s (%s)\n", d.Old.CLDRVersion, d.Old.Coverage, d.New.CLDRVersion, d.New.Coverage)
+	if len(d.AddedLocales) > 0 {
+		fmt.Fprintf(w, "\nAdded locales: %s\n", strings.Join(d.AddedLocales, ", "))
+	}
+	if len(d.RemovedLocales) > 0 {
+		fmt.Fprintf(w, "\nRemoved locales: %s\n", strings.Join(d.RemovedLocales, ", "))
+	}
+	if len(d.AddedLocales) == 0 && len(d.RemovedLocales) == 0 && len(d.Locales) == 0 {
+		fmt.Fprintln(w, "\nNo differences.")
+		return
+	}
+
+	for _, locale := range d.Locales {
+		for _, typeDiff := range locale.Types {
+			fmt.Fprintf(w, "\n%s (%s)\n", locale.Locale, typeDiff.CompactType)
+			for _, change := range typeDiff.Changes {
+				switch {
+				case change.PluralForm == "" && change.Kind == changed:
+					fmt.Fprintf(w, "  %s %d zeroes: %s → %s\n", change.Kind, change.Type, change.Old, change.New)
+				case change.PluralForm == "":
+					fmt.Fprintf(w, "  %s %d (rule)\n", change.Kind, change.Type)
+				case change.Kind == added:
+					fmt.Fprintf(w, "  %s %d %s: %q\n", change.Kind, change.Type, change.PluralForm, change.New)
+				case change.Kind == removed:
+					fmt.Fprintf(w, "  %s %d %s: %q\n", change.Kind, change.Type, change.PluralForm, change.Old)
+				default:
+					fmt.Fprintf(w, "  %s %d %s: %q → %q\n", change.Kind, change.Type, change.PluralForm, change.Old, change.New)
+				}
+			}
+
+			if len(typeDiff.Samples) == 0 {
+				fmt.Fprintln(w, "  no visible change in samples")
+				continue
+			}
+			fmt.Fprintln(w, "  samples:")
+			for _, sample := range typeDiff.Samples {
+				fmt.Fprintf(w, "    %d: %s → %s\n", sample.N, sample.Old, sample.New)
+			}
+		}
+	}
+}
+
+func runDiff(args []string) error {
+	opts, err := parseDiffOptions(args)
+	if err != nil {
+		return err
+	}
+
+	oldSet, err := loadDataSet(opts.oldPath, opts)
+	if err != nil {
+		return errors.New(fmt.Sprintf("error loading %s: %s", opts.oldPath, err.Error()))
+	}
+	newSet, err := loadDataSet(opts.newPath, opts)
+	if err != nil {
+		return errors.New(fmt.Sprintf("error loading %s: %s", opts.newPath, err.Error()))
+	}
+
+	diffDataSets(oldSet, newSet, opts.samples).writeReport(os.Stdout)
+	return nil
+}
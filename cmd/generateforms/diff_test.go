@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+	"github.com/nkall/compactnumber/internal/models"
+)
+
+func TestDiffDataSets(t *testing.T) {
+	oldSet := generationParams{
+		CLDRVersion: "35",
+		Coverage:    "modern",
+		CompactFormsByLanguage: map[string]map[compactnumber.CompactType][]models.CompactFormRule{
+			"en": {compactnumber.Short: {
+				{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"one": "0K", "other": "0K"}},
+			}},
+			"fr": {compactnumber.Short: {
+				{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"one": "0 k", "other": "0 k"}},
+			}},
+			"de": {compactnumber.Short: {
+				{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"one": "0", "other": "0"}},
+			}},
+		},
+	}
+	newSet := generationParams{
+		CLDRVersion: "36",
+		Coverage:    "modern",
+		CompactFormsByLanguage: map[string]map[compactnumber.CompactType][]models.CompactFormRule{
+			"en": {compactnumber.Short: {
+				{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"other": "0K"}},
+				{Type: 1000000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"one": "0M", "other": "0M"}},
+			}},
+			"ja": {compactnumber.Short: {
+				{Type: 10000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"other": "0万"}},
+			}},
+			"de": {compactnumber.Short: {
+				{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"one": "0", "other": "0"}},
+			}},
+		},
+	}
+
+	diff := diffDataSets(oldSet, newSet, nil)
+	if !reflect.DeepEqual(diff.AddedLocales, []string{"ja"}) || !reflect.DeepEqual(diff.RemovedLocales, []string{"fr"}) {
+		t.Errorf("got unexpected added locales %v and removed locales %v", diff.AddedLocales, diff.RemovedLocales)
+	}
+
+	// Unchanged locales are left out
+	expected := []localeDiff{{Locale: "en", Types: []compactTypeDiff{{
+		CompactType: compactnumber.Short,
+		Changes: []ruleChange{
+			{Kind: removed, Type: 1000, PluralForm: "one", Old: "0K"},
+			{Kind: added, Type: 1000000},
+		},
+		Samples: []sampleChange{
+			{N: 1000000, Old: "1,000K", New: "1M"},
+			{N: 2000000, Old: "2,000K", New: "2M"},
+			{N: 5000000, Old: "5,000K", New: "5M"},
+		},
+	}}}}
+	if !reflect.DeepEqual(diff.Locales, expected) {
+		t.Errorf("got unexpected locale diffs %+v (wanted %+v)", diff.Locales, expected)
+	}
+
+	var report bytes.Buffer
+	diff.writeReport(&report)
+	expectedReport := `Comparing CLDR 35 (modern) with CLDR 36 (modern)
+
+Added locales: ja
+
+Removed locales: fr
+
+en (Short)
+  - 1000 one: "0K"
+  + 1000000 (rule)
+  samples:
+    1000000: 1,000K → 1M
+    2000000: 2,000K → 2M
+    5000000: 5,000K → 5M
+`
+	if report.String() != expectedReport {
+		t.Errorf("got unexpected report %s (wanted %s)", report.String(), expectedReport)
+	}
+
+	// Requested samples replace the default ones
+	diff = diffDataSets(oldSet, newSet, []int64{-1500000})
+	if samples := diff.Locales[0].Types[0].Samples; !reflect.DeepEqual(samples, []sampleChange{{N: -1500000, Old: "-1,500K", New: "-1M"}}) {
+		t.Errorf("got unexpected samples %+v", samples)
+	}
+}
+
+func TestDiffZeroesInPattern(t *testing.T) {
+	oldSet := generationParams{
+		CLDRVersion: "35",
+		Coverage:    "modern",
+		CompactFormsByLanguage: map[string]map[compactnumber.CompactType][]models.CompactFormRule{
+			"sw": {compactnumber.Long: {
+				{Type: 1000, ZeroesInPattern: 2, PatternsByPluralForm: map[string]string{"one": "elfu 0", "other": "elfu 0"}},
+			}},
+		},
+	}
+	newSet := generationParams{
+		CLDRVersion: "36",
+		Coverage:    "modern",
+		CompactFormsByLanguage: map[string]map[compactnumber.CompactType][]models.CompactFormRule{
+			"sw": {compactnumber.Long: {
+				{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"one": "elfu 0", "other": "elfu 0"}},
+			}},
+		},
+	}
+
+	// The patterns are the same, but the numbers they are given are not
+	var report bytes.Buffer
+	diffDataSets(oldSet, newSet, []int64{2000}).writeReport(&report)
+	expectedReport := `Comparing CLDR 35 (modern) with CLDR 36 (modern)
+
+sw (Long)
+  ~ 1000 zeroes: 2 → 1
+  samples:
+    2000: elfu 20 → elfu 2
+`
+	if report.String() != expectedReport {
+		t.Errorf("got unexpected report %s (wanted %s)", report.String(), expectedReport)
+	}
+}
+
+func TestDiffIdenticalDataSets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generateforms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	opts := diffOptions{coverage: "modern"}
+	distribution, err := loadDataSet(fixtureCLDRPath, opts)
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+
+	// A bundle generated from the distribution holds the same rules
+	b, err := marshalBundle(newBundle(distribution))
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+	bundlePath := filepath.Join(dir, "forms.gen.json")
+	if err := ioutil.WriteFile(bundlePath, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	bundle, err := loadDataSet(bundlePath, opts)
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+
+	var report bytes.Buffer
+	diffDataSets(distribution, bundle, nil).writeReport(&report)
+	if expected := "Comparing CLDR 36 (modern) with CLDR 36 (modern)\n\nNo differences.\n"; report.String() != expected {
+		t.Errorf("got unexpected report %s (wanted %s)", report.String(), expected)
+	}
+}
+
+func TestParseDiffOptions(t *testing.T) {
+	opts, err := parseDiffOptions([]string{"-coverage", "full", "-samples", "1000, -2000", "old", "new"})
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+	expected := diffOptions{coverage: "full", samples: sampleList{1000, -2000}, oldPath: "old", newPath: "new"}
+	if !reflect.DeepEqual(opts, expected) {
+		t.Errorf("got unexpected options %+v (wanted %+v)", opts, expected)
+	}
+
+	if _, err := parseDiffOptions([]string{"old"}); err == nil {
+		t.Error("expected an error for a single data set")
+	}
+	if _, err := parseDiffOptions([]string{"-samples", "1K", "old", "new"}); err == nil {
+		t.Error("expected an error for an invalid sample")
+	}
+}
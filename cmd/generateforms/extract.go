@@ -0,0 +1,380 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nkall/compactnumber"
+	"github.com/nkall/compactnumber/internal/compact"
+	"github.com/nkall/compactnumber/internal/models"
+)
+
+type FileExtractResult struct {
+	Language              string
+	CLDRVersion           string
+	CompactForms          map[compactnumber.CompactType][]models.CompactFormRule
+	MiscPatterns          models.MiscPatterns
+	Signs                 models.Signs
+	MinimumGroupingDigits int
+}
+
+func extractFromFile(b []byte) (FileExtractResult, error) {
+	var file fileJson
+	err := json.Unmarshal(b, &file)
+	if err != nil {
+		return FileExtractResult{}, err
+	}
+
+	// Each file has just one language body, but the key isn't consistent
+	if len(file.Main) > 1 {
+		return FileExtractResult{}, errors.New("got multiple language bodies")
+	}
+
+	var body bodyJson
+	for _, fileBody := range file.Main {
+		body = fileBody
+		break
+	}
+
+	cldrVersion := body.Identity.Version.CLDRVersion
+	language := body.Identity.key()
+
+	defaultNumberingSystemBytes, ok := body.Numbers["defaultNumberingSystem"]
+	if !ok {
+		return FileExtractResult{}, errors.New("missing default numbering system")
+	}
+
+	var defaultNumberingSystem string
+	err = json.Unmarshal(defaultNumberingSystemBytes, &defaultNumberingSystem)
+	if err != nil {
+		return FileExtractResult{}, err
+	}
+
+	decimalFormatsBytes, ok := body.Numbers[fmt.Sprintf("decimalFormats-numberSystem-%s", defaultNumberingSystem)]
+	if !ok {
+		return FileExtractResult{}, errors.New(fmt.Sprintf("missing decimal formats for default numbering system %s", defaultNumberingSystem))
+	}
+
+	var decimalFormats decimalFormatsJson
+	err = json.Unmarshal(decimalFormatsBytes, &decimalFormats)
+	if err != nil {
+		return FileExtractResult{}, err
+	}
+
+	forms, err := extractCompactForms(decimalFormats)
+	if err != nil {
+		return FileExtractResult{}, err
+	}
+
+	miscPatternsBytes, ok := body.Numbers[fmt.Sprintf("miscPatterns-numberSystem-%s", defaultNumberingSystem)]
+	if !ok {
+		return FileExtractResult{}, errors.New(fmt.Sprintf("missing misc patterns for default numbering system %s", defaultNumberingSystem))
+	}
+
+	var miscPatterns miscPatternsJson
+	err = json.Unmarshal(miscPatternsBytes, &miscPatterns)
+	if err != nil {
+		return FileExtractResult{}, err
+	}
+
+	symbolsBytes, ok := body.Numbers[fmt.Sprintf("symbols-numberSystem-%s", defaultNumberingSystem)]
+	if !ok {
+		return FileExtractResult{}, errors.New(fmt.Sprintf("missing symbols for default numbering system %s", defaultNumberingSystem))
+	}
+
+	var symbols symbolsJson
+	err = json.Unmarshal(symbolsBytes, &symbols)
+	if err != nil {
+		return FileExtractResult{}, err
+	}
+
+	patterns, err := extractMiscPatterns(miscPatterns, symbols)
+	if err != nil {
+		return FileExtractResult{}, err
+	}
+
+	currencyFormatsBytes, ok := body.Numbers[fmt.Sprintf("currencyFormats-numberSystem-%s", defaultNumberingSystem)]
+	if !ok {
+		return FileExtractResult{}, errors.New(fmt.Sprintf("missing currency formats for default numbering system %s", defaultNumberingSystem))
+	}
+
+	var currencyFormats currencyFormatsJson
+	err = json.Unmarshal(currencyFormatsBytes, &currencyFormats)
+	if err != nil {
+		return FileExtractResult{}, err
+	}
+
+	signs, err := extractSigns(symbols, currencyFormats)
+	if err != nil {
+		return FileExtractResult{}, err
+	}
+
+	minimumGroupingDigitsBytes, ok := body.Numbers["minimumGroupingDigits"]
+	if !ok {
+		return FileExtractResult{}, errors.New("missing minimum grouping digits")
+	}
+
+	var minimumGroupingDigitsStr string
+	err = json.Unmarshal(minimumGroupingDigitsBytes, &minimumGroupingDigitsStr)
+	if err != nil {
+		return FileExtractResult{}, err
+	}
+
+	minimumGroupingDigits, err := strconv.Atoi(minimumGroupingDigitsStr)
+	if err != nil {
+		return FileExtractResult{}, err
+	}
+
+	return FileExtractResult{
+		Language:              language,
+		CLDRVersion:           cldrVersion,
+		CompactForms:          forms,
+		MiscPatterns:          patterns,
+		Signs:                 signs,
+		MinimumGroupingDigits: minimumGroupingDigits,
+	}, nil
+}
+
+// extractSigns extracts the plus sign and the affixes of negative numbers in accounting format. The affixes are taken
+// from the negative subpattern of the accounting currency format, leaving out the currency symbol, and are empty if
+// the locale marks negative amounts with a minus sign.
+func extractSigns(symbols symbolsJson, currencyFormats currencyFormatsJson) (models.Signs, error) {
+	if symbols.PlusSign == "" {
+		return models.Signs{}, errors.New("missing plus sign")
+	}
+	if currencyFormats.Accounting == "" {
+		return models.Signs{}, errors.New("missing accounting currency format")
+	}
+
+	signs := models.Signs{PlusSign: symbols.PlusSign}
+
+	subpatterns := strings.Split(currencyFormats.Accounting, ";")
+	if len(subpatterns) < 2 {
+		return signs, nil
+	}
+
+	negative := strings.Replace(subpatterns[1], "¤", "", -1)
+	start := strings.IndexAny(negative, "#0")
+	end := strings.LastIndexAny(negative, "#0")
+	if start == -1 {
+		return models.Signs{}, errors.New(fmt.Sprintf("invalid accounting currency format: %s", currencyFormats.Accounting))
+	}
+
+	prefix := strings.TrimSpace(negative[:start])
+	suffix := strings.TrimSpace(negative[end+1:])
+	if strings.Contains(prefix, "-") || strings.Contains(suffix, "-") {
+		return signs, nil
+	}
+
+	signs.AccountingPrefix, signs.AccountingSuffix = prefix, suffix
+	return signs, nil
+}
+
+func extractMiscPatterns(miscPatterns miscPatternsJson, symbols symbolsJson) (models.MiscPatterns, error) {
+	patterns := models.MiscPatterns{
+		Approximately: miscPatterns.Approximately,
+		AtLeast:       miscPatterns.AtLeast,
+		AtMost:        miscPatterns.AtMost,
+		Range:         miscPatterns.Range,
+	}
+
+	for _, pattern := range []string{patterns.Approximately, patterns.AtLeast, patterns.AtMost, patterns.Range} {
+		if !strings.Contains(pattern, "{0}") {
+			return models.MiscPatterns{}, errors.New(fmt.Sprintf("invalid misc pattern: %s", pattern))
+		}
+	}
+	if !strings.Contains(patterns.Range, "{1}") {
+		return models.MiscPatterns{}, errors.New(fmt.Sprintf("invalid range pattern: %s", patterns.Range))
+	}
+
+	// "~" stands for the approximately sign in the approximately pattern, which only newer CLDR versions have
+	if symbols.ApproximatelySign != "" {
+		patterns.Approximately = strings.Replace(patterns.Approximately, "~", symbols.ApproximatelySign, -1)
+	}
+
+	return patterns, nil
+}
+
+// extractPluralRanges extracts the plural ranges of every language from the supplemental pluralRanges.json, along
+// with its CLDR version.
+func extractPluralRanges(b []byte) (map[string]models.PluralRanges, string, error) {
+	var file pluralRangesFileJson
+	err := json.Unmarshal(b, &file)
+	if err != nil {
+		return nil, "", err
+	}
+
+	prefix, endString := "pluralRange-start-", "-end-"
+	pluralRangesByLanguage := make(map[string]models.PluralRanges, len(file.Supplemental.PluralRanges))
+	for language, ranges := range file.Supplemental.PluralRanges {
+		pluralRanges := make(models.PluralRanges)
+		for rangeName, pluralForm := range ranges {
+			endIndex := strings.Index(rangeName, endString)
+			if !strings.HasPrefix(rangeName, prefix) || endIndex == -1 {
+				return nil, "", errors.New(fmt.Sprintf("unexpected plural range %s for language %s", rangeName, language))
+			}
+
+			start, end := rangeName[len(prefix):endIndex], rangeName[endIndex+len(endString):]
+			if pluralRanges[start] == nil {
+				pluralRanges[start] = make(map[string]string)
+			}
+			pluralRanges[start][end] = pluralForm
+		}
+		pluralRangesByLanguage[language] = pluralRanges
+	}
+
+	return pluralRangesByLanguage, file.Supplemental.Version.CLDRVersion, nil
+}
+
+func extractCompactForms(formats decimalFormatsJson) (map[compactnumber.CompactType][]models.CompactFormRule, error) {
+	if len(formats.Long.DecimalFormat) == 0 {
+		return nil, errors.New("missing long formats")
+	} else if len(formats.Short.DecimalFormat) == 0 {
+		return nil, errors.New("missing short formats")
+	}
+
+	longForms, err := extractCompactFormRules(formats.Long.DecimalFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	shortForms, err := extractCompactFormRules(formats.Short.DecimalFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[compactnumber.CompactType][]models.CompactFormRule{
+		compactnumber.Short: shortForms,
+		compactnumber.Long:  longForms,
+	}, nil
+}
+
+func extractCompactFormRules(formatRules map[string]string) ([]models.CompactFormRule, error) {
+	countString := "-count-"
+	rules := make([]models.CompactFormRule, 0, len(formatRules))
+
+	// We expect to iterate in sorted order
+	formatNames := make([]string, 0, len(formatRules))
+	for formatName := range formatRules {
+		formatNames = append(formatNames, formatName)
+	}
+	sort.Strings(formatNames)
+
+	currType := int64(-1)
+	var currRule *models.CompactFormRule
+	for _, formatName := range formatNames {
+		countIndex := strings.Index(formatName, countString)
+		if countIndex == -1 {
+			return nil, errors.New(fmt.Sprintf("missing count from %s", formatName))
+		}
+		typeStr := formatName[:countIndex]
+		typeNum, err := strconv.ParseInt(typeStr, 10, 0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		formatPattern := formatRules[formatName]
+		zeroesCount := compact.CountZeroes(formatPattern)
+		if zeroesCount == 0 {
+			return nil, errors.New(fmt.Sprintf("missing zeroes from pattern: %s", formatPattern))
+		}
+
+		if typeNum != currType {
+			if currRule != nil {
+				rules = append(rules, *currRule)
+			}
+			currType = typeNum
+			currRule = &models.CompactFormRule{
+				Type:                 typeNum,
+				ZeroesInPattern:      zeroesCount,
+				PatternsByPluralForm: make(map[string]string),
+			}
+		}
+
+		afterCountIndex := countIndex + len(countString)
+		pluralForm := formatName[afterCountIndex:]
+
+		if currRule != nil {
+			currRule.PatternsByPluralForm[pluralForm] = formatPattern
+		} else {
+			return nil, errors.New("current rule is nil (bad programmer)")
+		}
+	}
+
+	if currRule != nil {
+		rules = append(rules, *currRule)
+	}
+
+	return rules, nil
+}
+
+// JSON unmarshalling structs
+type fileJson struct {
+	Main map[string]bodyJson `json:"main"`
+}
+
+type bodyJson struct {
+	Identity identityJson               `json:"identity"`
+	Numbers  map[string]json.RawMessage `json:"numbers"`
+}
+
+type identityJson struct {
+	Version struct {
+		CLDRVersion string `json:"_cldrVersion"`
+	} `json:"version"`
+	Language  string `json:"language"`
+	Script    string `json:"script"`
+	Territory string `json:"territory"`
+	Variant   string `json:"variant"`
+}
+
+// key returns the locale the identity is generated under, e.g. "zh-Hant-HK". The script is part of it, as languages
+// written in several scripts have a locale per script, such as zh and zh-Hant or sr and sr-Latn.
+func (i identityJson) key() string {
+	subtags := []string{i.Language}
+	for _, subtag := range []string{i.Script, i.Territory, i.Variant} {
+		if subtag != "" {
+			subtags = append(subtags, subtag)
+		}
+	}
+	return strings.Join(subtags, "-")
+}
+
+type decimalFormatsJson struct {
+	Long  decimalFormatJson `json:"long"`
+	Short decimalFormatJson `json:"short"`
+}
+
+type decimalFormatJson struct {
+	DecimalFormat map[string]string `json:"decimalFormat"`
+}
+
+type miscPatternsJson struct {
+	Approximately string `json:"approximately"`
+	AtLeast       string `json:"atLeast"`
+	AtMost        string `json:"atMost"`
+	Range         string `json:"range"`
+}
+
+type symbolsJson struct {
+	PlusSign          string `json:"plusSign"`
+	ApproximatelySign string `json:"approximatelySign"`
+}
+
+type currencyFormatsJson struct {
+	Accounting string `json:"accounting"`
+}
+
+type pluralRangesFileJson struct {
+	Supplemental struct {
+		Version struct {
+			CLDRVersion string `json:"_cldrVersion"`
+		} `json:"version"`
+		PluralRanges map[string]map[string]string `json:"pluralRanges"`
+	} `json:"supplemental"`
+}
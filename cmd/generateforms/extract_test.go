@@ -0,0 +1,139 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nkall/compactnumber/internal/models"
+)
+
+func TestExtractMiscPatternsAndSigns(t *testing.T) {
+	params, err := loadCompactForms(generateOptions{cldrPath: fixtureCLDRPath, coverage: "modern"})
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+
+	defaultPatterns := models.MiscPatterns{Approximately: "~{0}", AtLeast: "{0}+", AtMost: "≤{0}", Range: "{0}–{1}"}
+	tests := []struct {
+		locale           string
+		expectedPatterns models.MiscPatterns
+		expectedSigns    models.Signs
+	}{
+		// Accounting formats with a negative subpattern in parentheses give the accounting affixes
+		{locale: "en", expectedPatterns: defaultPatterns, expectedSigns: models.Signs{PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"}},
+		{locale: "zh-Hant", expectedPatterns: defaultPatterns, expectedSigns: models.Signs{PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"}},
+		// Accounting formats without a negative subpattern, or with a minus sign in it, give none
+		{locale: "sr", expectedPatterns: defaultPatterns, expectedSigns: models.Signs{PlusSign: "+"}},
+		// The approximately sign replaces "~" in the approximately pattern
+		{locale: "sr-Latn", expectedPatterns: models.MiscPatterns{Approximately: "≈{0}", AtLeast: "≥{0}", AtMost: "≤{0}", Range: "{0} – {1}"}, expectedSigns: models.Signs{PlusSign: "+"}},
+	}
+	for _, tt := range tests {
+		if patterns := params.MiscPatternsByLanguage[tt.locale]; patterns != tt.expectedPatterns {
+			t.Errorf("got unexpected misc patterns %+v for %s (wanted %+v)", patterns, tt.locale, tt.expectedPatterns)
+		}
+		if signs := params.SignsByLanguage[tt.locale]; signs != tt.expectedSigns {
+			t.Errorf("got unexpected signs %+v for %s (wanted %+v)", signs, tt.locale, tt.expectedSigns)
+		}
+		if digits := params.MinimumGroupingDigitsByLanguage[tt.locale]; digits != 1 {
+			t.Errorf("got unexpected minimum grouping digits %d for %s", digits, tt.locale)
+		}
+	}
+}
+
+func TestExtractSignsErrors(t *testing.T) {
+	tests := []struct {
+		name            string
+		symbols         symbolsJson
+		currencyFormats currencyFormatsJson
+		expectedErr     string
+	}{
+		{name: "no plus sign", currencyFormats: currencyFormatsJson{Accounting: "¤#,##0.00"}, expectedErr: "missing plus sign"},
+		{name: "no accounting format", symbols: symbolsJson{PlusSign: "+"}, expectedErr: "missing accounting currency format"},
+		{name: "no digits", symbols: symbolsJson{PlusSign: "+"}, currencyFormats: currencyFormatsJson{Accounting: "¤#,##0.00;(¤)"}, expectedErr: "invalid accounting currency format"},
+	}
+	for _, tt := range tests {
+		if _, err := extractSigns(tt.symbols, tt.currencyFormats); err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+			t.Errorf("got unexpected error %v for %s (wanted %s)", err, tt.name, tt.expectedErr)
+		}
+	}
+}
+
+func TestExtractMiscPatternsErrors(t *testing.T) {
+	valid := miscPatternsJson{Approximately: "~{0}", AtLeast: "{0}+", AtMost: "≤{0}", Range: "{0}–{1}"}
+	noPlaceholder, noEnd := valid, valid
+	noPlaceholder.AtLeast = "+"
+	noEnd.Range = "{0}–"
+
+	if _, err := extractMiscPatterns(valid, symbolsJson{}); err != nil {
+		t.Errorf("got unexpected error %v", err)
+	}
+	if _, err := extractMiscPatterns(noPlaceholder, symbolsJson{}); err == nil || !strings.Contains(err.Error(), "invalid misc pattern: +") {
+		t.Errorf("got unexpected error %v for a pattern without a placeholder", err)
+	}
+	if _, err := extractMiscPatterns(noEnd, symbolsJson{}); err == nil || !strings.Contains(err.Error(), "invalid range pattern") {
+		t.Errorf("got unexpected error %v for a range pattern without an end", err)
+	}
+}
+
+func TestGenerateFixture(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generateforms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	opts := generateOptions{
+		cldrPath:    fixtureCLDRPath,
+		coverage:    "modern",
+		templateDir: ".",
+		outPath:     filepath.Join(dir, "forms.gen.go"),
+		testOutPath: filepath.Join(dir, "forms_test.go"),
+		jsonOutPath: filepath.Join(dir, "forms.gen.json"),
+		tsOutPath:   filepath.Join(dir, "forms.gen.ts"),
+	}
+	if err := generate(opts); err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+
+	bundle, err := loadBundle(opts.jsonOutPath)
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+	if approximately := bundle.MiscPatternsByLanguage["sr-Latn"].Approximately; approximately != "≈{0}" {
+		t.Errorf("got unexpected approximately pattern %s for sr-Latn", approximately)
+	}
+	if signs := bundle.SignsByLanguage["en"]; signs != (models.Signs{PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"}) {
+		t.Errorf("got unexpected signs %+v for en", signs)
+	}
+	// Plural ranges are kept for the languages with compact forms
+	if len(bundle.PluralRangesByLanguage) != 3 || bundle.PluralRangesByLanguage["sr"]["one"]["few"] != "few" {
+		t.Errorf("got unexpected plural ranges %v", bundle.PluralRangesByLanguage)
+	}
+
+	// The generated Go file holds the same tables as the bundle
+	generated, err := loadGeneratedFile(opts.outPath)
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(generated, bundle) {
+		t.Errorf("got tables %+v from the generated file (wanted %+v)", generated, bundle)
+	}
+
+	ts, err := ioutil.ReadFile(opts.tsOutPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(ts), `"approximately": "≈{0}"`) {
+		t.Error("the TypeScript module doesn't embed the bundle")
+	}
+
+	// The outputs are up to date with the distribution they were generated from
+	opts.check = true
+	if err := generate(opts); err != nil {
+		t.Errorf("got unexpected error %v", err)
+	}
+}
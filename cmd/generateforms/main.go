@@ -1,277 +1,480 @@
+// Command generateforms extracts compact number forms from a CLDR JSON distribution and generates forms.gen.go
+// and forms_test.go from them, along with a JSON data bundle and a TypeScript module for other clients.
+//
+// Usage:
+//
+//	go run ./cmd/generateforms [flags]
+//	go run ./cmd/generateforms diff [flags] OLD NEW
+//
+// The diff command compares two CLDR distributions, generated files or data bundles and reports the changed rules per
+// locale, along with sample numbers rendered before and after. Run either command with -h for the full list of flags.
 package main
 
 import (
-	"bufio"
+	"bytes"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
+	"go/format"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
 	"sort"
-	"strconv"
 	"strings"
 	"text/template"
-	"time"
 
 	"github.com/nkall/compactnumber"
+	"github.com/nkall/compactnumber/internal/compact"
 	"github.com/nkall/compactnumber/internal/models"
 )
 
 type generationParams struct {
-	CompactFormsByLanguage map[string]map[compactnumber.CompactType][]models.CompactFormRule
-	Timestamp              time.Time
-	CLDRVersion            string
+	CompactFormsByLanguage          map[string]map[compactnumber.CompactType][]models.CompactFormRule
+	MiscPatternsByLanguage          map[string]models.MiscPatterns
+	SignsByLanguage                 map[string]models.Signs
+	MinimumGroupingDigitsByLanguage map[string]int
+	PluralRangesByLanguage          map[string]models.PluralRanges
+	CLDRVersion                     string
+	Coverage                        string
+	Conformance                     conformanceCorpus
 }
 
-type FileExtractResult struct {
-	Language     string
-	CLDRVersion  string
-	CompactForms map[compactnumber.CompactType][]models.CompactFormRule
+// generateOptions holds the flags of the generate command.
+type generateOptions struct {
+	cldrPath    string
+	coverage    string
+	include     localeList
+	exclude     localeList
+	templateDir string
+	corpusPath  string
+	outPath     string
+	testOutPath string
+	jsonOutPath string
+	tsOutPath   string
+	check       bool
+	dryRun      bool
+	keepGoing   bool
 }
 
-func extractFromFile(b []byte) (FileExtractResult, error) {
-	var file fileJson
-	err := json.Unmarshal(b, &file)
-	if err != nil {
-		return FileExtractResult{}, err
-	}
+// localeList is a comma-separated list of CLDR locale names. A locale matches an entry if it is equal to it or if the
+// entry is one of its parents, so "en" matches "en", "en-GB" and "en-US-POSIX".
+type localeList []string
+
+func (l *localeList) String() string {
+	return strings.Join(*l, ",")
+}
 
-	// Each file has just one language body, but the key isn't consistent
-	if len(file.Main) > 1 {
-		return FileExtractResult{}, errors.New("got multiple language bodies")
+func (l *localeList) Set(s string) error {
+	for _, locale := range strings.Split(s, ",") {
+		if locale = strings.TrimSpace(locale); locale != "" {
+			*l = append(*l, locale)
+		}
 	}
+	return nil
+}
 
-	var body bodyJson
-	for _, fileBody := range file.Main {
-		body = fileBody
-		break
+func (l localeList) matches(locale string) bool {
+	for _, entry := range l {
+		if locale == entry || strings.HasPrefix(locale, entry+"-") {
+			return true
+		}
 	}
+	return false
+}
 
-	cldrVersion := body.Identity.Version.CLDRVersion
-	language := body.Identity.Language
-	if body.Identity.Territory != "" {
-		language = fmt.Sprintf("%s-%s", language, body.Identity.Territory)
+func parseGenerateOptions(args []string) (generateOptions, error) {
+	var opts generateOptions
+	fs := flag.NewFlagSet("generateforms", flag.ContinueOnError)
+	fs.StringVar(&opts.cldrPath, "cldr", "./cldr", "root of the CLDR JSON distribution, including the core package")
+	fs.StringVar(&opts.coverage, "coverage", "modern", "CLDR coverage level to read (modern or full)")
+	fs.Var(&opts.include, "locales", "comma-separated CLDR locales to generate, including their sublocales (default all)")
+	fs.Var(&opts.exclude, "exclude", "comma-separated CLDR locales to skip, including their sublocales")
+	fs.StringVar(&opts.templateDir, "templates", "./cmd/generateforms", "directory containing the templates of the generated files")
+	fs.StringVar(&opts.corpusPath, "conformance", "./cmd/generateforms/testdata/conformance.json", "conformance corpus to generate golden tests from (empty to skip)")
+	fs.StringVar(&opts.outPath, "out", "./forms.gen.go", "output path of the generated forms")
+	fs.StringVar(&opts.testOutPath, "test-out", "./forms_test.go", "output path of the generated tests")
+	fs.StringVar(&opts.jsonOutPath, "json-out", "./forms.gen.json", "output path of the JSON data bundle (empty to skip)")
+	fs.StringVar(&opts.tsOutPath, "ts-out", "./forms.gen.ts", "output path of the TypeScript module (empty to skip)")
+	fs.BoolVar(&opts.check, "check", false, "verify that the outputs are up to date instead of writing them")
+	fs.BoolVar(&opts.dryRun, "dry-run", false, "extract and render everything, but do not write any files")
+	fs.BoolVar(&opts.keepGoing, "keep-going", false, "skip locales that fail to extract instead of failing the run")
+	if err := fs.Parse(args); err != nil {
+		return generateOptions{}, err
 	}
 
-	defaultNumberingSystemBytes, ok := body.Numbers["defaultNumberingSystem"]
-	if !ok {
-		return FileExtractResult{}, errors.New("missing default numbering system")
+	if opts.coverage != "modern" && opts.coverage != "full" {
+		return generateOptions{}, errors.New(fmt.Sprintf("invalid coverage level %q (expected modern or full)", opts.coverage))
+	}
+	if opts.check && opts.dryRun {
+		return generateOptions{}, errors.New("-check and -dry-run are mutually exclusive")
 	}
 
-	var defaultNumberingSystem string
-	err = json.Unmarshal(defaultNumberingSystemBytes, &defaultNumberingSystem)
-	if err != nil {
-		return FileExtractResult{}, err
+	return opts, nil
+}
+
+// localesDir finds the directory holding one subdirectory per locale. The CLDR JSON packages are named after their
+// coverage level (cldr-numbers-modern, cldr-numbers-full) and keep their locales under "main", but the contents of
+// "main" may also have been extracted directly into the root. The generated files are labelled with the coverage
+// level, so it is an error for the distribution to be of another one, as far as it can be told from its layout or
+// its package.json.
+func localesDir(cldrPath string, coverage string) (string, error) {
+	dir := filepath.Join(cldrPath, fmt.Sprintf("cldr-numbers-%s", coverage), "main")
+	if isDir(dir) {
+		return dir, nil
 	}
 
-	decimalFormatsBytes, ok := body.Numbers[fmt.Sprintf("decimalFormats-numberSystem-%s", defaultNumberingSystem)]
-	if !ok {
-		return FileExtractResult{}, errors.New(fmt.Sprintf("missing decimal formats for default numbering system %s", defaultNumberingSystem))
+	for _, other := range []string{"modern", "full"} {
+		if other != coverage && isDir(filepath.Join(cldrPath, fmt.Sprintf("cldr-numbers-%s", other), "main")) {
+			return "", errors.New(fmt.Sprintf("%s has no cldr-numbers-%s package but has cldr-numbers-%s; pass -coverage %s to generate from it", cldrPath, coverage, other, other))
+		}
 	}
 
-	var decimalFormats decimalFormatsJson
-	err = json.Unmarshal(decimalFormatsBytes, &decimalFormats)
-	if err != nil {
-		return FileExtractResult{}, err
+	dir = cldrPath
+	if isDir(filepath.Join(cldrPath, "main")) {
+		dir = filepath.Join(cldrPath, "main")
 	}
 
-	forms, err := extractCompactForms(decimalFormats)
-	if err != nil {
-		return FileExtractResult{}, err
+	name, err := packageName(cldrPath)
+	switch {
+	case err != nil:
+		return "", err
+	case name == "":
+		log.Printf("The coverage level of %s is unknown; labelling the generated files with -coverage %s.\n", cldrPath, coverage)
+	case name != fmt.Sprintf("cldr-numbers-%s", coverage):
+		return "", errors.New(fmt.Sprintf("%s holds the %s package, not cldr-numbers-%s", cldrPath, name, coverage))
 	}
 
-	return FileExtractResult{
-		Language:     language,
-		CLDRVersion:  cldrVersion,
-		CompactForms: forms,
-	}, nil
+	return dir, nil
 }
 
-func extractCompactForms(formats decimalFormatsJson) (map[compactnumber.CompactType][]models.CompactFormRule, error) {
-	if len(formats.Long.DecimalFormat) == 0 {
-		return nil, errors.New("missing long formats")
-	} else if len(formats.Short.DecimalFormat) == 0 {
-		return nil, errors.New("missing short formats")
+// packageName reads the name of the CLDR JSON package extracted into dir from its package.json, or returns an empty
+// name if there is none.
+func packageName(dir string) (string, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, "package.json"))
+	if os.IsNotExist(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
 	}
 
-	longForms, err := extractCompactFormRules(formats.Long.DecimalFormat)
-	if err != nil {
-		return nil, err
+	var body struct {
+		Name string `json:"name"`
 	}
-
-	shortForms, err := extractCompactFormRules(formats.Short.DecimalFormat)
-	if err != nil {
-		return nil, err
+	if err := json.Unmarshal(b, &body); err != nil {
+		return "", errors.New(fmt.Sprintf("error reading %s: %s", filepath.Join(dir, "package.json"), err.Error()))
 	}
 
-	return map[compactnumber.CompactType][]models.CompactFormRule{
-		compactnumber.Short: shortForms,
-		compactnumber.Long:  longForms,
-	}, nil
+	return body.Name, nil
 }
 
-func extractCompactFormRules(formatRules map[string]string) ([]models.CompactFormRule, error) {
-	countString := "-count-"
-	rules := make([]models.CompactFormRule, 0, len(formatRules))
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
 
-	// We expect to iterate in sorted order
-	formatNames := make([]string, 0, len(formatRules))
-	for formatName := range formatRules {
-		formatNames = append(formatNames, formatName)
+func loadCompactForms(opts generateOptions) (generationParams, error) {
+	dir, err := localesDir(opts.cldrPath, opts.coverage)
+	if err != nil {
+		return generationParams{}, err
+	}
+	dirs, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return generationParams{}, err
+	}
+
+	params := generationParams{
+		CompactFormsByLanguage:          make(map[string]map[compactnumber.CompactType][]models.CompactFormRule),
+		MiscPatternsByLanguage:          make(map[string]models.MiscPatterns),
+		SignsByLanguage:                 make(map[string]models.Signs),
+		MinimumGroupingDigitsByLanguage: make(map[string]int),
+		Coverage:                        opts.coverage,
 	}
-	sort.Strings(formatNames)
 
-	currType := int64(-1)
-	var currRule *models.CompactFormRule
-	for _, formatName := range formatNames {
-		countIndex := strings.Index(formatName, countString)
-		if countIndex == -1 {
-			return nil, errors.New(fmt.Sprintf("missing count from %s", formatName))
+	var failed []string
+	dirsByLanguage := make(map[string]string)
+	for _, d := range dirs {
+		if !d.IsDir() {
+			log.Printf("Skipping non-directory entry %s\n", d.Name())
+			continue
 		}
-		typeStr := formatName[:countIndex]
-		typeNum, err := strconv.ParseInt(typeStr, 10, 0)
+		if (len(opts.include) > 0 && !opts.include.matches(d.Name())) || opts.exclude.matches(d.Name()) {
+			continue
+		}
+		log.Printf("Processing directory %s...\n", d.Name())
 
+		forms, err := extractFromDir(filepath.Join(dir, d.Name()))
 		if err != nil {
-			return nil, err
+			log.Printf("error extracting forms from %s: %s", d.Name(), err.Error())
+			failed = append(failed, d.Name())
+			continue
 		}
 
-		formatPattern := formatRules[formatName]
-		zeroesCount := strings.Count(formatPattern, "0")
-		if zeroesCount == 0 {
-			return nil, errors.New(fmt.Sprintf("missing zeroes from pattern: %s", formatPattern))
+		if params.CLDRVersion != "" && params.CLDRVersion != forms.CLDRVersion {
+			log.Printf("error extracting forms from %s: CLDR version %s does not match version %s of other locales", d.Name(), forms.CLDRVersion, params.CLDRVersion)
+			failed = append(failed, d.Name())
+			continue
 		}
-
-		if typeNum != currType {
-			if currRule != nil {
-				rules = append(rules, *currRule)
-			}
-			currType = typeNum
-			currRule = &models.CompactFormRule{
-				Type:                 typeNum,
-				ZeroesInPattern:      zeroesCount,
-				PatternsByPluralForm: make(map[string]string),
-			}
+		// Two locales with the same key would silently overwrite each other, leaving whichever is read last
+		if other, ok := dirsByLanguage[forms.Language]; ok {
+			log.Printf("error extracting forms from %s: locale %s was already extracted from %s", d.Name(), forms.Language, other)
+			failed = append(failed, d.Name())
+			continue
 		}
+		dirsByLanguage[forms.Language] = d.Name()
 
-		afterCountIndex := countIndex + len(countString)
-		pluralForm := formatName[afterCountIndex:]
+		params.CLDRVersion = forms.CLDRVersion
+		params.CompactFormsByLanguage[forms.Language] = forms.CompactForms
+		params.MiscPatternsByLanguage[forms.Language] = forms.MiscPatterns
+		params.SignsByLanguage[forms.Language] = forms.Signs
+		params.MinimumGroupingDigitsByLanguage[forms.Language] = forms.MinimumGroupingDigits
 
-		if currRule != nil {
-			currRule.PatternsByPluralForm[pluralForm] = formatPattern
-		} else {
-			return nil, errors.New("current rule is nil (bad programmer)")
+		// Special case -- nb (Norsk Bokmål) is sometimes classified as no (Norsk)
+		if forms.Language == "nb" {
+			params.CompactFormsByLanguage["no"] = forms.CompactForms
+			params.MiscPatternsByLanguage["no"] = forms.MiscPatterns
+			params.SignsByLanguage["no"] = forms.Signs
+			params.MinimumGroupingDigitsByLanguage["no"] = forms.MinimumGroupingDigits
 		}
 	}
 
-	return rules, nil
+	if len(failed) > 0 && !opts.keepGoing {
+		return generationParams{}, errors.New(fmt.Sprintf("failed to extract %d locale(s): %s", len(failed), strings.Join(failed, ", ")))
+	}
+	if len(params.CompactFormsByLanguage) == 0 {
+		return generationParams{}, errors.New(fmt.Sprintf("no locales found in %s", dir))
+	}
+
+	return params, nil
 }
 
-func writeFormsFile(params generationParams, inFilePath string, outFilePath string) error {
-	templateFile, err := ioutil.ReadFile(inFilePath)
-	if err != nil {
-		return errors.New(fmt.Sprintf("error reading template file: %s", err.Error()))
+// supplementalDir finds the directory holding the supplemental data of the CLDR core package, which is either
+// cldr-core/supplemental or supplemental in the root of the distribution.
+func supplementalDir(cldrPath string) string {
+	dir := filepath.Join(cldrPath, "cldr-core", "supplemental")
+	if info, err := os.Stat(dir); err == nil && info.IsDir() {
+		return dir
 	}
+	return filepath.Join(cldrPath, "supplemental")
+}
 
-	templ, err := template.New("").Parse(string(templateFile))
+// loadPluralRanges loads the plural ranges of the languages compact forms were extracted for. Languages without plural
+// ranges use the plural form of the end of the range.
+func loadPluralRanges(opts generateOptions, params generationParams) (map[string]models.PluralRanges, error) {
+	path := filepath.Join(supplementalDir(opts.cldrPath), "pluralRanges.json")
+	b, err := ioutil.ReadFile(path)
 	if err != nil {
-		return errors.New(fmt.Sprintf("error parsing template file: %s", err.Error()))
+		return nil, err
 	}
 
-	f, err := os.Create(outFilePath)
+	allPluralRanges, cldrVersion, err := extractPluralRanges(b)
 	if err != nil {
-		return errors.New(fmt.Sprintf("error parsing template file: %s", err.Error()))
+		return nil, errors.New(fmt.Sprintf("error extracting plural ranges from %s: %s", path, err.Error()))
+	}
+	if cldrVersion != params.CLDRVersion {
+		return nil, errors.New(fmt.Sprintf("CLDR version %s of %s does not match version %s of the locales", cldrVersion, path, params.CLDRVersion))
 	}
 
-	defer f.Close()
-
-	w := bufio.NewWriter(f)
-	err = templ.Execute(w, params)
-	if err != nil {
-		return err
+	pluralRangesByLanguage := make(map[string]models.PluralRanges)
+	for language, pluralRanges := range allPluralRanges {
+		if _, ok := params.CompactFormsByLanguage[language]; ok {
+			pluralRangesByLanguage[language] = pluralRanges
+		}
 	}
 
-	w.Flush()
+	// Special case -- nb (Norsk Bokmål) is sometimes classified as no (Norsk)
+	if pluralRanges, ok := pluralRangesByLanguage["nb"]; ok {
+		pluralRangesByLanguage["no"] = pluralRanges
+	}
 
-	return nil
+	return pluralRangesByLanguage, nil
 }
 
-func main() {
-	cldrPath := "./cldr"
-	dirs, err := ioutil.ReadDir(cldrPath)
+func extractFromDir(dir string) (FileExtractResult, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, "numbers.json"))
 	if err != nil {
-		log.Fatal(err)
+		return FileExtractResult{}, err
 	}
 
-	var genParams generationParams
-	genParams.CompactFormsByLanguage = make(map[string]map[compactnumber.CompactType][]models.CompactFormRule)
+	return extractFromFile(b)
+}
 
-	for _, d := range dirs {
-		if !d.IsDir() {
-			log.Printf("Skipping non-directory entry %s\n", d.Name())
-			continue
-		}
-		log.Printf("Processing directory %s...\n", d.Name())
+// validateCompactForms logs every finding of the validator and fails if any of them is an error.
+func validateCompactForms(params generationParams) error {
+	locales := make([]string, 0, len(params.CompactFormsByLanguage))
+	for locale := range params.CompactFormsByLanguage {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
 
-		b, err := ioutil.ReadFile(fmt.Sprintf("%s/%s/numbers.json", cldrPath, d.Name()))
-		if err != nil {
-			log.Fatalf("error reading file at %s: %s", d.Name(), err.Error())
+	errorCount := 0
+	for _, locale := range locales {
+		forms := make(map[string][]models.CompactFormRule)
+		for compactType, rules := range params.CompactFormsByLanguage[locale] {
+			forms[string(compactType)] = rules
 		}
 
-		forms, err := extractFromFile(b)
-		if err != nil {
-			log.Fatalf("error extracting forms from %s: %s", d.Name(), err.Error())
+		for _, finding := range compact.Validate(locale, forms) {
+			log.Println(finding)
+			if finding.Severity == compact.Error {
+				errorCount++
+			}
 		}
-		genParams.CLDRVersion = forms.CLDRVersion
-		genParams.CompactFormsByLanguage[forms.Language] = forms.CompactForms
+	}
 
-		// Special case -- nb (Norsk Bokmål) is sometimes classified as no (Norsk)
-		if forms.Language == "nb" {
-			genParams.CompactFormsByLanguage["no"] = forms.CompactForms
-		}
+	if errorCount > 0 {
+		return errors.New(fmt.Sprintf("validation found %d error(s) in the extracted compact forms", errorCount))
 	}
 
-	genParams.Timestamp = time.Now()
+	return nil
+}
 
-	err = writeFormsFile(genParams, "./cmd/generateforms/forms.tmpl", "./forms.gen.go")
+// executeTemplate executes the template at templatePath with the given data.
+func executeTemplate(data interface{}, templatePath string) ([]byte, error) {
+	templateFile, err := ioutil.ReadFile(templatePath)
 	if err != nil {
-		log.Fatal(err)
+		return nil, errors.New(fmt.Sprintf("error reading template file: %s", err.Error()))
 	}
 
-	log.Println("Successfully generated compact formats file.")
+	templ, err := template.New(filepath.Base(templatePath)).Parse(string(templateFile))
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("error parsing template file: %s", err.Error()))
+	}
 
-	err = writeFormsFile(genParams, "./cmd/generateforms/forms_test.tmpl", "./forms_test.go")
+	var buf bytes.Buffer
+	err = templ.Execute(&buf, data)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
-	log.Println("Successfully generated compact formats test file.")
+	return buf.Bytes(), nil
 }
 
-// JSON unmarshalling structs
-type fileJson struct {
-	Main map[string]bodyJson `json:"main"`
+// renderTemplate executes the Go template at templatePath and returns the gofmt-ed result.
+func renderTemplate(params generationParams, templatePath string) ([]byte, error) {
+	src, err := executeTemplate(params, templatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := format.Source(src)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("error formatting output of %s: %s", templatePath, err.Error()))
+	}
+
+	return out, nil
 }
 
-type bodyJson struct {
-	Identity identityJson               `json:"identity"`
-	Numbers  map[string]json.RawMessage `json:"numbers"`
+// generatedFile is a rendered output and the path it belongs at.
+type generatedFile struct {
+	path    string
+	content []byte
 }
 
-type identityJson struct {
-	Version struct {
-		CLDRVersion string `json:"_cldrVersion"`
-	} `json:"version"`
-	Language  string `json:"language"`
-	Territory string `json:"territory"`
-	Variant   string `json:"variant"`
+// checkFile reports an error if the file at the generated path differs from the generated content.
+func checkFile(file generatedFile) error {
+	existing, err := ioutil.ReadFile(file.path)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(existing, file.content) {
+		return errors.New(fmt.Sprintf("%s is out of date; run `make generate`", file.path))
+	}
+
+	return nil
 }
 
-type decimalFormatsJson struct {
-	Long  decimalFormatJson `json:"long"`
-	Short decimalFormatJson `json:"short"`
+func generate(opts generateOptions) error {
+	params, err := loadCompactForms(opts)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Extracted compact forms for %d locales from CLDR %s (%s).\n", len(params.CompactFormsByLanguage), params.CLDRVersion, params.Coverage)
+
+	if err := validateCompactForms(params); err != nil {
+		return err
+	}
+
+	params.PluralRangesByLanguage, err = loadPluralRanges(opts, params)
+	if err != nil {
+		return err
+	}
+
+	if opts.corpusPath != "" {
+		params.Conformance, err = loadConformanceCorpus(opts.corpusPath, params.CompactFormsByLanguage)
+		if err != nil {
+			return err
+		}
+		log.Printf("Loaded %d conformance cases from %s.\n", len(params.Conformance.Cases), opts.corpusPath)
+	}
+
+	var files []generatedFile
+	for _, target := range []struct{ templateName, path string }{
+		{templateName: "forms.tmpl", path: opts.outPath},
+		{templateName: "forms_test.tmpl", path: opts.testOutPath},
+	} {
+		content, err := renderTemplate(params, filepath.Join(opts.templateDir, target.templateName))
+		if err != nil {
+			return err
+		}
+		files = append(files, generatedFile{path: target.path, content: content})
+	}
+
+	if opts.jsonOutPath != "" || opts.tsOutPath != "" {
+		bundleFiles, err := renderBundleFiles(params, opts)
+		if err != nil {
+			return err
+		}
+		files = append(files, bundleFiles...)
+	}
+
+	var stale []string
+	for _, file := range files {
+		switch {
+		case opts.check:
+			if err := checkFile(file); err != nil {
+				log.Println(err)
+				stale = append(stale, file.path)
+			}
+		case opts.dryRun:
+			log.Printf("Would write %d bytes to %s.\n", len(file.content), file.path)
+		default:
+			if err := ioutil.WriteFile(file.path, file.content, 0644); err != nil {
+				return err
+			}
+			log.Printf("Successfully generated %s.\n", file.path)
+		}
+	}
+
+	if len(stale) > 0 {
+		return errors.New(fmt.Sprintf("generated files are not up to date: %s", strings.Join(stale, ", ")))
+	}
+
+	return nil
 }
 
-type decimalFormatJson struct {
-	DecimalFormat map[string]string `json:"decimalFormat"`
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		err := runDiff(os.Args[2:])
+		if err == flag.ErrHelp {
+			os.Exit(0)
+		} else if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	opts, err := parseGenerateOptions(os.Args[1:])
+	if err == flag.ErrHelp {
+		os.Exit(0)
+	} else if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := generate(opts); err != nil {
+		log.Fatal(err)
+	}
 }
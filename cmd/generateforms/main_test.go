@@ -0,0 +1,172 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+)
+
+// fixtureCLDRPath is a minimal CLDR JSON distribution with locales of several scripts: en, sr, sr-Latn, zh and zh-Hant.
+const fixtureCLDRPath = "testdata/cldr"
+
+func TestLoadCompactFormsScripts(t *testing.T) {
+	params, err := loadCompactForms(generateOptions{cldrPath: fixtureCLDRPath, coverage: "modern"})
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+
+	if params.CLDRVersion != "36" {
+		t.Errorf("got unexpected CLDR version %s", params.CLDRVersion)
+	}
+
+	tests := []struct {
+		locale          string
+		expectedPattern string
+	}{
+		{locale: "en", expectedPattern: "0M"},
+		{locale: "sr", expectedPattern: "0 мил."},
+		{locale: "sr-Latn", expectedPattern: "0 mil."},
+		{locale: "zh", expectedPattern: "000万"},
+		{locale: "zh-Hant", expectedPattern: "000萬"},
+	}
+	if len(params.CompactFormsByLanguage) != len(tests) {
+		t.Errorf("got %d locales (wanted %d)", len(params.CompactFormsByLanguage), len(tests))
+	}
+	for _, tt := range tests {
+		forms, ok := params.CompactFormsByLanguage[tt.locale]
+		if !ok {
+			t.Errorf("missing locale %s", tt.locale)
+			continue
+		}
+
+		rules := forms[compactnumber.Short]
+		if pattern := rules[len(rules)-1].PatternsByPluralForm["other"]; pattern != tt.expectedPattern {
+			t.Errorf("got unexpected pattern %s for %s (wanted %s)", pattern, tt.locale, tt.expectedPattern)
+		}
+	}
+}
+
+func TestLoadCompactFormsDuplicateLocale(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generateforms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Both directories hold the identity of zh-Hant
+	b, err := ioutil.ReadFile(filepath.Join(fixtureCLDRPath, "cldr-numbers-modern", "main", "zh-Hant", "numbers.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"zh-Hant", "zh-TW"} {
+		if err := os.MkdirAll(filepath.Join(dir, "main", name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, "main", name, "numbers.json"), b, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err = loadCompactForms(generateOptions{cldrPath: dir, coverage: "modern"})
+	if err == nil || !strings.Contains(err.Error(), "zh-TW") {
+		t.Errorf("got unexpected error %v (wanted a failure of zh-TW)", err)
+	}
+
+	params, err := loadCompactForms(generateOptions{cldrPath: dir, coverage: "modern", keepGoing: true})
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+	if len(params.CompactFormsByLanguage) != 1 {
+		t.Errorf("got %d locales (wanted 1)", len(params.CompactFormsByLanguage))
+	}
+}
+
+func TestLocaleListMatches(t *testing.T) {
+	var list localeList
+	if err := list.Set("en, sr-Latn,"); err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+	if list.String() != "en,sr-Latn" {
+		t.Errorf("got unexpected list %s", list.String())
+	}
+
+	tests := []struct {
+		locale   string
+		expected bool
+	}{
+		{locale: "en", expected: true},
+		{locale: "en-GB", expected: true},
+		{locale: "en-US-POSIX", expected: true},
+		{locale: "sr-Latn", expected: true},
+		{locale: "sr-Latn-BA", expected: true},
+		// Entries match whole subtags only, and not their parents
+		{locale: "eo", expected: false},
+		{locale: "enx", expected: false},
+		{locale: "sr", expected: false},
+		{locale: "sr-Cyrl", expected: false},
+	}
+	for _, tt := range tests {
+		if matches := list.matches(tt.locale); matches != tt.expected {
+			t.Errorf("got unexpected match %t for %s", matches, tt.locale)
+		}
+	}
+
+	if (localeList{}).matches("en") {
+		t.Error("got unexpected match of an empty list")
+	}
+}
+
+func TestLocalesDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generateforms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// The contents of the cldr-numbers-full package, extracted directly
+	fullDir := filepath.Join(dir, "full")
+	if err := os.MkdirAll(filepath.Join(fullDir, "main"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(fullDir, "package.json"), []byte(`{"name": "cldr-numbers-full"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// The contents of main, extracted without a package.json
+	unknownDir := filepath.Join(dir, "unknown")
+	if err := os.MkdirAll(filepath.Join(unknownDir, "en"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		cldrPath    string
+		coverage    string
+		expectedDir string
+		expectedErr string
+	}{
+		{cldrPath: fixtureCLDRPath, coverage: "modern", expectedDir: filepath.Join(fixtureCLDRPath, "cldr-numbers-modern", "main")},
+		{cldrPath: fixtureCLDRPath, coverage: "full", expectedErr: "pass -coverage modern"},
+		{cldrPath: fullDir, coverage: "full", expectedDir: filepath.Join(fullDir, "main")},
+		{cldrPath: fullDir, coverage: "modern", expectedErr: "holds the cldr-numbers-full package, not cldr-numbers-modern"},
+		{cldrPath: unknownDir, coverage: "full", expectedDir: unknownDir},
+	}
+	for _, tt := range tests {
+		out, err := localesDir(tt.cldrPath, tt.coverage)
+		switch {
+		case tt.expectedErr != "" && (err == nil || !strings.Contains(err.Error(), tt.expectedErr)):
+			t.Errorf("got unexpected error %v for %s with %s coverage (wanted %s)", err, tt.cldrPath, tt.coverage, tt.expectedErr)
+		case tt.expectedErr == "" && err != nil:
+			t.Errorf("got unexpected error %v for %s with %s coverage", err, tt.cldrPath, tt.coverage)
+		case out != tt.expectedDir:
+			t.Errorf("got unexpected directory %s for %s with %s coverage (wanted %s)", out, tt.cldrPath, tt.coverage, tt.expectedDir)
+		}
+	}
+
+	// Generating fails rather than labelling the files with the wrong coverage
+	if _, err := loadCompactForms(generateOptions{cldrPath: fixtureCLDRPath, coverage: "full"}); err == nil {
+		t.Error("expected an error for a distribution of another coverage level")
+	}
+}
@@ -0,0 +1,75 @@
+package compactnumber
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+var (
+	// ErrUnsupportedLocale is matched by errors for locales without compact forms, see LocaleError.
+	ErrUnsupportedLocale = errors.New("unsupported locale")
+	// ErrMissingPluralForm is matched by errors for plural categories without a pattern, see PluralError.
+	ErrMissingPluralForm = errors.New("missing plural form")
+	// ErrInvalidPattern is matched by errors for CLDR patterns that can't be formatted, see PatternError.
+	ErrInvalidPattern = errors.New("invalid pattern")
+)
+
+// LocaleError is returned for a locale without compact forms. Fallback is the locale that was tried in its place: its
+// base language, or the default locale of FallbackLocale. It is language.Und if there was none.
+type LocaleError struct {
+	Requested language.Tag
+	Fallback  language.Tag
+}
+
+func (e *LocaleError) Error() string {
+	if e.Fallback == language.Und {
+		return fmt.Sprintf("no compact forms or fallback for language %s", e.Requested.String())
+	}
+
+	return fmt.Sprintf("missing compact forms for language %s and fallback %s", e.Requested.String(), e.Fallback.String())
+}
+
+// Unwrap returns ErrUnsupportedLocale.
+func (e *LocaleError) Unwrap() error {
+	return ErrUnsupportedLocale
+}
+
+// PluralError is returned with FallbackStrict for a number whose plural category has no pattern in the rule of its
+// type, rather than formatting it with the "other" pattern.
+type PluralError struct {
+	Locale   language.Tag
+	Type     int64
+	Category PluralCategory
+}
+
+func (e *PluralError) Error() string {
+	return fmt.Sprintf("no %s pattern for type %d in language %s", e.Category, e.Type, e.Locale.String())
+}
+
+// Unwrap returns ErrMissingPluralForm.
+func (e *PluralError) Unwrap() error {
+	return ErrMissingPluralForm
+}
+
+// PatternError is returned for a CLDR pattern that can't be formatted, e.g. one without digits.
+type PatternError struct {
+	Locale  language.Tag
+	Pattern string
+	Err     error
+}
+
+func (e *PatternError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the error the pattern failed with.
+func (e *PatternError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrInvalidPattern.
+func (e *PatternError) Is(target error) bool {
+	return target == ErrInvalidPattern
+}
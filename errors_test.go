@@ -0,0 +1,90 @@
+package compactnumber_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+	"golang.org/x/text/language"
+)
+
+func TestLocaleError(t *testing.T) {
+	formatter := compactnumber.NewFormatter("tlh", compactnumber.Short)
+	_, err := formatter.Format(1234)
+	if !errors.Is(err, compactnumber.ErrUnsupportedLocale) {
+		t.Fatalf("got unexpected error %v (wanted %v)", err, compactnumber.ErrUnsupportedLocale)
+	}
+
+	var localeErr *compactnumber.LocaleError
+	if !errors.As(err, &localeErr) {
+		t.Fatalf("got unexpected error type %T", err)
+	}
+	if localeErr.Requested != language.Make("tlh") || localeErr.Fallback != language.Make("tlh") {
+		t.Errorf("got unexpected locales %s and %s", localeErr.Requested, localeErr.Fallback)
+	}
+	if expected := "missing compact forms for language tlh and fallback tlh"; err.Error() != expected {
+		t.Errorf("got unexpected message %s (wanted %s)", err.Error(), expected)
+	}
+
+	_, err = compactnumber.Rules(language.Make("tlh"), compactnumber.Short)
+	if !errors.Is(err, compactnumber.ErrUnsupportedLocale) {
+		t.Errorf("got unexpected error %v from Rules", err)
+	}
+}
+
+func TestFallback(t *testing.T) {
+	tests := []struct {
+		name        string
+		lang        string
+		options     []compactnumber.FormatterOption
+		expectedOut string
+		expectedErr error
+	}{
+		{name: "other", lang: "tlh", expectedErr: compactnumber.ErrUnsupportedLocale},
+		{name: "strict", lang: "tlh", options: []compactnumber.FormatterOption{compactnumber.Fallback(compactnumber.FallbackStrict)}, expectedErr: compactnumber.ErrUnsupportedLocale},
+		{name: "strict supported", lang: "ru", options: []compactnumber.FormatterOption{compactnumber.Fallback(compactnumber.FallbackStrict)}, expectedOut: "1 млн"},
+		{name: "locale", lang: "tlh", options: []compactnumber.FormatterOption{compactnumber.Fallback(compactnumber.FallbackLocale)}, expectedOut: "1M"},
+		{name: "default locale", lang: "tlh", options: []compactnumber.FormatterOption{compactnumber.DefaultLocale("de")}, expectedOut: "1 Mio."},
+		{name: "default locale supported", lang: "fr", options: []compactnumber.FormatterOption{compactnumber.DefaultLocale("de")}, expectedOut: "1 M"},
+		{name: "unsupported default locale", lang: "tlh", options: []compactnumber.FormatterOption{compactnumber.DefaultLocale("x-klingon")}, expectedErr: compactnumber.ErrUnsupportedLocale},
+		{name: "none", lang: "tlh", options: []compactnumber.FormatterOption{compactnumber.Fallback(compactnumber.FallbackNone)}, expectedOut: "1,234,567"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.lang, compactnumber.Short, tt.options...)
+			out, err := formatter.Format(1234567)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Errorf("got unexpected error %v (wanted %v)", err, tt.expectedErr)
+			}
+			if out != tt.expectedOut {
+				t.Errorf("got unexpected output %s (wanted %s)", out, tt.expectedOut)
+			}
+		})
+	}
+
+	// Every way of formatting applies the policy
+	formatter := compactnumber.NewFormatter("tlh", compactnumber.Short, compactnumber.Fallback(compactnumber.FallbackLocale))
+	out, err := formatter.FormatRange(1000, 5000)
+	mustMatch(t, out, err, "1K–5K", nil)
+	out, err = formatter.FormatFit(1234567, 2)
+	mustMatch(t, out, err, "1M", nil)
+}
+
+func TestPluralAndPatternErrors(t *testing.T) {
+	var err error = &compactnumber.PluralError{Locale: language.German, Type: 1000000, Category: compactnumber.PluralOne}
+	if !errors.Is(err, compactnumber.ErrMissingPluralForm) {
+		t.Errorf("got unexpected error %v (wanted %v)", err, compactnumber.ErrMissingPluralForm)
+	}
+	if expected := "no one pattern for type 1000000 in language de"; err.Error() != expected {
+		t.Errorf("got unexpected message %s (wanted %s)", err.Error(), expected)
+	}
+
+	cause := errors.New("invalid pattern (no digit pattern characters): K")
+	err = &compactnumber.PatternError{Locale: language.English, Pattern: "K", Err: cause}
+	if !errors.Is(err, compactnumber.ErrInvalidPattern) || !errors.Is(err, cause) {
+		t.Errorf("got unexpected error %v (wanted %v)", err, compactnumber.ErrInvalidPattern)
+	}
+	if errors.Is(err, compactnumber.ErrUnsupportedLocale) {
+		t.Errorf("got unexpected match of %v", compactnumber.ErrUnsupportedLocale)
+	}
+}
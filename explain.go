@@ -0,0 +1,151 @@
+package compactnumber
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+// Explanation describes how Format formatted a number, for debugging localization issues.
+type Explanation struct {
+	N    int
+	Text string
+	// Requested is the locale of the formatter, and Locale the one whose compact forms were used, e.g. "de" for
+	// "de-DE", or language.Und if there were none.
+	Requested   language.Tag
+	Locale      language.Tag
+	CompactType CompactType
+	// Type is the type of the rule the number was formatted with, or 0 if there was none, and Divisor the number its
+	// absolute value was divided by, or 1 if it was formatted in full.
+	Type    int64
+	Divisor int64
+	// Mantissa is the absolute value divided by the divisor, before rounding, and Rounded the mantissa the plural
+	// category was selected from, after rounding, in decimal notation with its visible fraction zeroes.
+	Mantissa float64
+	Rounded  string
+	// Operands are the plural operands of Rounded, which the plural category was selected from.
+	Operands PluralOperands
+	Category PluralCategory
+	// Pattern is the CLDR pattern of the rule for the plural category, and CompiledPattern the pattern for
+	// Printer.Sprintf it was processed to. A CompiledPattern of "0" formats the number in full.
+	Pattern         string
+	CompiledPattern string
+	// Fallbacks are the fallbacks taken, as reported to the callback of OnFallback.
+	Fallbacks []FallbackEvent
+}
+
+// PluralOperands are the operands of the CLDR plural rules for a number, as documented in the CLDR spec:
+// http://unicode.org/reports/tr35/tr35-numbers.html#Operands
+//
+// The plural rules of the CLDR data don't use the exponent yet, so the plural category of a compacted number is selected
+// from its rounded mantissa without one, and Explanation.Operands are those of the mantissa: "1.2M" is n=1.2 i=1 v=1
+// w=1 f=2 t=2 e=0, not CLDR's n=1200000 i=1200000 v=0 w=0 f=0 t=0 e=6 of the full number.
+type PluralOperands struct {
+	// The absolute value of the number.
+	N float64
+	// The integer digits of the number.
+	I int64
+	// The number of visible fraction digits, with and without trailing zeroes.
+	V int
+	W int
+	// The visible fraction digits, with and without trailing zeroes.
+	F int64
+	T int64
+	// The exponent of the power of ten of compact decimal formatting, which the plural category isn't selected with
+	// yet, so it is 0 in an Explanation.
+	E int
+}
+
+// String returns the operands in CLDR notation, e.g. `n=1.2 i=1 v=1 w=1 f=2 t=2 e=0`.
+func (o PluralOperands) String() string {
+	return fmt.Sprintf("n=%s i=%d v=%d w=%d f=%d t=%d e=%d", strconv.FormatFloat(o.N, 'f', -1, 64), o.I, o.V, o.W, o.F, o.T, o.E)
+}
+
+// Explain formats n like Format and describes how: the locale data, rule and pattern it was formatted with, its
+// mantissa and plural category, and the fallbacks taken. The callback of OnFallback is not called.
+func (f *Formatter) Explain(n int, numOptions ...number.Option) (Explanation, error) {
+	var fallbacks []FallbackEvent
+	explaining := *f
+	explaining.onFallback = func(event FallbackEvent) {
+		fallbacks = append(fallbacks, event)
+	}
+
+	parts, num, err := explaining.formatParts(n, 0, numOptions)
+	if err != nil {
+		return Explanation{}, err
+	}
+
+	locale := dataLocale(f.lang)
+	for _, event := range fallbacks {
+		if event.Reason == ReasonDefaultLocale {
+			locale = event.Locale
+		}
+	}
+
+	// Capped numbers are formatted as the cap
+	if f.capped && n > f.max {
+		n = f.max
+	}
+
+	return Explanation{
+		N:               n,
+		Text:            joinParts(parts),
+		Requested:       f.lang,
+		Locale:          locale,
+		CompactType:     f.compactType,
+		Type:            num.rule.Type,
+		Divisor:         num.divisor,
+		Mantissa:        math.Abs(float64(n)) / float64(num.divisor),
+		Rounded:         num.decimal,
+		Operands:        pluralOperands(num.decimal),
+		Category:        PluralCategory(num.pluralForm),
+		Pattern:         patternFor(num.rule, num.pluralForm),
+		CompiledPattern: num.pattern,
+		Fallbacks:       fallbacks,
+	}, nil
+}
+
+// String returns the explanation as readable text, one step per line.
+func (e Explanation) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d in %s (data %s) %s: %s\n", e.N, e.Requested.String(), e.Locale.String(), e.CompactType, e.Text)
+	fmt.Fprintf(&b, "rule: type %d, divisor %d\n", e.Type, e.Divisor)
+	fmt.Fprintf(&b, "mantissa: %s, rounded %s\n", strconv.FormatFloat(e.Mantissa, 'f', -1, 64), e.Rounded)
+	fmt.Fprintf(&b, "plural: %s (%s)\n", e.Category, e.Operands)
+	fmt.Fprintf(&b, "pattern: %q, compiled %q\n", e.Pattern, e.CompiledPattern)
+	for _, event := range e.Fallbacks {
+		fmt.Fprintf(&b, "fallback: %s (locale %s", event.Reason, event.Locale.String())
+		if event.Type != 0 {
+			fmt.Fprintf(&b, ", type %d", event.Type)
+		}
+		if event.Category != "" {
+			fmt.Fprintf(&b, ", category %s", event.Category)
+		}
+		b.WriteString(")\n")
+	}
+
+	return b.String()
+}
+
+// Gets the plural operands of a non-negative number in decimal notation, e.g. n=1.2 i=1 v=1 w=1 f=2 t=2 e=0 for "1.2".
+func pluralOperands(decimal string) PluralOperands {
+	var operands PluralOperands
+	operands.N, _ = strconv.ParseFloat(decimal, 64)
+
+	integer, fraction := decimal, ""
+	if i := strings.IndexByte(decimal, '.'); i >= 0 {
+		integer, fraction = decimal[:i], decimal[i+1:]
+	}
+	operands.I, _ = strconv.ParseInt(integer, 10, 64)
+
+	trimmed := strings.TrimRight(fraction, "0")
+	operands.V, operands.W = len(fraction), len(trimmed)
+	operands.F, _ = strconv.ParseInt(fraction, 10, 64)
+	operands.T, _ = strconv.ParseInt(trimmed, 10, 64)
+
+	return operands
+}
@@ -0,0 +1,100 @@
+package compactnumber_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+func TestExplain(t *testing.T) {
+	formatter := compactnumber.NewFormatter("en-US", compactnumber.Short, compactnumber.Precision(compactnumber.PrecisionCompact))
+	explanation, err := formatter.Explain(-1234567)
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+
+	expected := compactnumber.Explanation{
+		N:               -1234567,
+		Text:            "-1.2M",
+		Requested:       language.Make("en-US"),
+		Locale:          language.Make("en-US"),
+		CompactType:     compactnumber.Short,
+		Type:            1000000,
+		Divisor:         1000000,
+		Mantissa:        1.234567,
+		Rounded:         "1.2",
+		Operands:        compactnumber.PluralOperands{N: 1.2, I: 1, V: 1, W: 1, F: 2, T: 2},
+		Category:        compactnumber.PluralOther,
+		Pattern:         "0M",
+		CompiledPattern: "%vM",
+	}
+	if !reflect.DeepEqual(explanation, expected) {
+		t.Errorf("got unexpected explanation %+v (wanted %+v)", explanation, expected)
+	}
+}
+
+func TestExplainOperands(t *testing.T) {
+	tests := []struct {
+		n                int
+		compactType      compactnumber.CompactType
+		numOptions       []number.Option
+		expectedRounded  string
+		expectedOperands compactnumber.PluralOperands
+	}{
+		// The operands are those of the rounded mantissa the plural category is selected from, without an exponent
+		{n: 1500, compactType: compactnumber.Long, numOptions: []number.Option{number.MinFractionDigits(2)}, expectedRounded: "1.50", expectedOperands: compactnumber.PluralOperands{N: 1.5, I: 1, V: 2, W: 1, F: 50, T: 5}},
+		{n: 1234567, compactType: compactnumber.Short, numOptions: []number.Option{number.MaxFractionDigits(4)}, expectedRounded: "1.2346", expectedOperands: compactnumber.PluralOperands{N: 1.2346, I: 1, V: 4, W: 4, F: 2346, T: 2346}},
+		// Visible fraction zeroes count as fraction digits
+		{n: 1234, compactType: compactnumber.Short, numOptions: []number.Option{number.MinFractionDigits(5)}, expectedRounded: "1.23400", expectedOperands: compactnumber.PluralOperands{N: 1.234, I: 1, V: 5, W: 3, F: 23400, T: 234}},
+		// Numbers formatted in full have no exponent
+		{n: 999, compactType: compactnumber.Short, expectedRounded: "999", expectedOperands: compactnumber.PluralOperands{N: 999, I: 999}},
+	}
+	for _, tt := range tests {
+		formatter := compactnumber.NewFormatter("en-US", tt.compactType)
+		explanation, err := formatter.Explain(tt.n, tt.numOptions...)
+		if err != nil {
+			t.Fatalf("got unexpected error %v", err)
+		}
+
+		if explanation.Rounded != tt.expectedRounded || explanation.Operands != tt.expectedOperands {
+			t.Errorf("got unexpected mantissa %s and operands %s for %d (wanted %s and %s)", explanation.Rounded, explanation.Operands, tt.n, tt.expectedRounded, tt.expectedOperands)
+		}
+	}
+}
+
+func TestExplainString(t *testing.T) {
+	var events int
+	formatter := compactnumber.NewFormatter("tlh", compactnumber.Long,
+		compactnumber.DefaultLocale("de"),
+		compactnumber.OnFallback(func(compactnumber.FallbackEvent) { events++ }),
+	)
+	explanation, err := formatter.Explain(1234)
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+
+	expected := `1234 in tlh (data de) Long: 1 Tausend
+rule: type 1000, divisor 1000
+mantissa: 1.234, rounded 1
+plural: one (n=1 i=1 v=0 w=0 f=0 t=0 e=0)
+pattern: "0 Tausend", compiled "%v Tausend"
+fallback: default-locale (locale de)
+`
+	if out := explanation.String(); out != expected {
+		t.Errorf("got unexpected text %s (wanted %s)", out, expected)
+	}
+
+	// Explaining doesn't report fallbacks
+	if events != 0 {
+		t.Errorf("got %d fallback events (wanted 0)", events)
+	}
+
+	formatter = compactnumber.NewFormatter("tlh", compactnumber.Long)
+	_, err = formatter.Explain(1234)
+	if err == nil {
+		t.Error("got no error for unsupported locale")
+	}
+}
@@ -0,0 +1,61 @@
+package compactnumber
+
+import (
+	"github.com/nkall/compactnumber/internal/compact"
+	"github.com/nkall/compactnumber/internal/models"
+	"golang.org/x/text/language"
+)
+
+// FallbackEvent describes a number formatted with other data than its locale calls for, reported to the callback of
+// OnFallback.
+type FallbackEvent struct {
+	// Requested is the locale of the formatter.
+	Requested language.Tag
+	// Locale is the locale whose compact forms were used, e.g. "de" for "de-DE", or language.Und if there were none.
+	Locale language.Tag
+	Reason FallbackReason
+	// Type is the type of the rule the number was formatted with, or 0 if there was none.
+	Type int64
+	// Category is the plural category of the number, or empty if it was not selected.
+	Category PluralCategory
+}
+
+// Reports a fallback to the callback of OnFallback, if any. The event is only built if there is a callback, so
+// formatters without one don't pay for it.
+func (f *Formatter) reportFallback(reason FallbackReason, rule models.CompactFormRule, pluralForm string) {
+	if f.onFallback == nil {
+		return
+	}
+
+	requested := f.lang
+	if f.requested != language.Und {
+		requested = f.requested
+	}
+
+	locale := language.Und
+	if reason != ReasonNoCompactForms {
+		locale = dataLocale(f.lang)
+	}
+
+	f.onFallback(FallbackEvent{
+		Requested: requested,
+		Locale:    locale,
+		Reason:    reason,
+		Type:      rule.Type,
+		Category:  PluralCategory(pluralForm),
+	})
+}
+
+// Gets the locale whose compact forms lookupCompactForms finds for a language, e.g. "zh-Hant" for "zh-TW".
+func dataLocale(lang language.Tag) language.Tag {
+	for i, key := range compact.DataKeys(lang) {
+		if _, ok := compactFormsByLanguage[key]; ok {
+			if i == 0 {
+				return lang
+			}
+			return language.Make(key)
+		}
+	}
+
+	return language.Und
+}
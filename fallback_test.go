@@ -0,0 +1,57 @@
+package compactnumber_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+	"golang.org/x/text/language"
+)
+
+func TestOnFallback(t *testing.T) {
+	tests := []struct {
+		name           string
+		lang           string
+		options        []compactnumber.FormatterOption
+		n              int
+		expectedEvents []compactnumber.FallbackEvent
+	}{
+		{name: "compacted", lang: "de-DE", n: 1234567},
+		{name: "below the first type", lang: "en", n: 999},
+		{
+			name: "uncompacted", lang: "de-DE", n: 1234,
+			expectedEvents: []compactnumber.FallbackEvent{
+				{Requested: language.Make("de-DE"), Locale: language.German, Reason: compactnumber.ReasonUncompacted, Type: 1000},
+			},
+		},
+		{
+			name: "default locale", lang: "tlh", options: []compactnumber.FormatterOption{compactnumber.DefaultLocale("de")}, n: 1234567,
+			expectedEvents: []compactnumber.FallbackEvent{
+				{Requested: language.Make("tlh"), Locale: language.German, Reason: compactnumber.ReasonDefaultLocale},
+			},
+		},
+		{
+			name: "none", lang: "tlh", options: []compactnumber.FormatterOption{compactnumber.Fallback(compactnumber.FallbackNone)}, n: 1234567,
+			expectedEvents: []compactnumber.FallbackEvent{
+				{Requested: language.Make("tlh"), Locale: language.Und, Reason: compactnumber.ReasonNoCompactForms},
+				{Requested: language.Make("tlh"), Locale: language.Und, Reason: compactnumber.ReasonNoPluralRules, Category: compactnumber.PluralOther},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []compactnumber.FallbackEvent
+			options := append(tt.options, compactnumber.OnFallback(func(event compactnumber.FallbackEvent) {
+				events = append(events, event)
+			}))
+
+			formatter := compactnumber.NewFormatter(tt.lang, compactnumber.Short, options...)
+			if _, err := formatter.Format(tt.n); err != nil {
+				t.Fatalf("got unexpected error %v", err)
+			}
+			if !reflect.DeepEqual(events, tt.expectedEvents) {
+				t.Errorf("got unexpected events %+v (wanted %+v)", events, tt.expectedEvents)
+			}
+		})
+	}
+}
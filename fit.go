@@ -0,0 +1,96 @@
+package compactnumber
+
+import (
+	"errors"
+	"fmt"
+	"unicode"
+
+	"github.com/nkall/compactnumber/internal/compact"
+	"golang.org/x/text/number"
+	"golang.org/x/text/width"
+)
+
+// maxFitFractionDigits is the greatest number of fraction digits FormatFit tries compact forms with.
+const maxFitFractionDigits = 2
+
+// FormatFit formats an integer in the most informative way that is at most maxWidth display columns wide. It tries
+// the full number first, then the locale's long and short compact forms with two, one and no fraction digits, e.g.
+// "1,234,567", "1.23 million", "1.23M", "1.2 million", "1.2M", "1 million" and "1M" in English. Compacted numbers are
+// rounded like Format does, so they are truncated unless Round sets another rounding mode, and show no more fraction
+// digits than the formatter's precision allows. It returns an error if none of them fit.
+//
+// East Asian wide and fullwidth characters take up two columns, and combining marks and format characters none.
+func (f *Formatter) FormatFit(n int, maxWidth int, numOptions ...number.Option) (string, error) {
+	f, compactForms, err := f.compactForms()
+	if err != nil {
+		return "", err
+	}
+
+	uncompacted := *f
+	uncompacted.compactType = None
+	uncompacted.threshold = 0
+
+	out, err := uncompacted.format(n, 0, numOptions)
+	if err != nil {
+		return "", err
+	}
+	if displayWidth(out) <= maxWidth {
+		return out, nil
+	}
+
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+
+	narrowest := out
+	for fractionDigits := maxFitFractionDigits; fractionDigits >= 0; fractionDigits-- {
+		for _, compactType := range []CompactType{Long, Short} {
+			// Numbers the locale doesn't compact have no shorter form
+			rule := compact.SelectRule(compactForms[compactType], int64(abs))
+			if rule.Type == 0 || rule.PatternsByPluralForm["other"] == "0" {
+				continue
+			}
+
+			// The number is rounded as by Format, with at most as many fraction digits as the precision allows. Formatters
+			// without a precision show integers, so they get the fraction digits tried instead.
+			candidate := *f
+			candidate.compactType = compactType
+			candidate.threshold = 0
+			if !candidate.precise {
+				candidate.precise = true
+				candidate.maxFractionDigits = maxFitFractionDigits
+			}
+			candidate.limitFractionDigits, candidate.fractionDigitsLimit = true, fractionDigits
+			out, err := candidate.format(n, 0, numOptions)
+			if err != nil {
+				return "", err
+			}
+			if displayWidth(out) <= maxWidth {
+				return out, nil
+			}
+			if displayWidth(out) < displayWidth(narrowest) {
+				narrowest = out
+			}
+		}
+	}
+
+	return "", errors.New(fmt.Sprintf("no format of %d fits in %d columns (narrowest is %q)", n, maxWidth, narrowest))
+}
+
+// Measures the number of columns a string takes up in a terminal.
+func displayWidth(s string) int {
+	columns := 0
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.Cc):
+			continue
+		case width.LookupRune(r).Kind() == width.EastAsianWide, width.LookupRune(r).Kind() == width.EastAsianFullwidth:
+			columns += 2
+		default:
+			columns++
+		}
+	}
+
+	return columns
+}
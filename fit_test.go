@@ -0,0 +1,53 @@
+package compactnumber_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+)
+
+func TestFormatterFormatFit(t *testing.T) {
+	tests := []struct {
+		localeStr   string
+		n           int
+		maxWidth    int
+		options     []compactnumber.FormatterOption
+		expectedOut string
+	}{
+		{localeStr: "en-US", n: 1234567, maxWidth: 9, expectedOut: "1,234,567"},
+		{localeStr: "en-US", n: 1234567, maxWidth: 12, expectedOut: "1,234,567"},
+		{localeStr: "en-US", n: 123456789, maxWidth: 12, expectedOut: "123,456,789"},
+		{localeStr: "en-US", n: 123456789, maxWidth: 10, expectedOut: "123.45M"},
+		{localeStr: "en-US", n: 1234567, maxWidth: 8, expectedOut: "1.23M"},
+		{localeStr: "en-US", n: 1234567, maxWidth: 4, expectedOut: "1.2M"},
+		{localeStr: "en-US", n: 1234567, maxWidth: 2, expectedOut: "1M"},
+		{localeStr: "en-US", n: 1999999, maxWidth: 4, expectedOut: "1.9M"},
+		{localeStr: "en-US", n: -1234567, maxWidth: 5, expectedOut: "-1.2M"},
+		// Long forms are preferred at the same precision
+		{localeStr: "en-US", n: 1234567000, maxWidth: 12, expectedOut: "1.23 billion"},
+		// Wide characters take up two columns
+		{localeStr: "ja", n: 123456789, maxWidth: 6, expectedOut: "1.23億"},
+		{localeStr: "ja", n: 123456789, maxWidth: 5, expectedOut: "1.2億"},
+		// Format characters take up none
+		{localeStr: "ar-SA", n: -69540001, maxWidth: 9, expectedOut: "\u200e-69 مليون"},
+		// Numbers are rounded with the formatter's rounding mode and precision
+		{localeStr: "en-US", n: 1999999, maxWidth: 2, options: []compactnumber.FormatterOption{compactnumber.Round(compactnumber.RoundHalfUp)}, expectedOut: "2M"},
+		{localeStr: "en-US", n: 1234567, maxWidth: 8, options: []compactnumber.FormatterOption{compactnumber.Round(compactnumber.RoundHalfUp)}, expectedOut: "1.23M"},
+		{localeStr: "en-US", n: 1255555, maxWidth: 4, options: []compactnumber.FormatterOption{compactnumber.Round(compactnumber.RoundHalfUp)}, expectedOut: "1.3M"},
+		{localeStr: "en-US", n: 1234567, maxWidth: 8, options: []compactnumber.FormatterOption{compactnumber.SignificantDigits(2)}, expectedOut: "1.2M"},
+		{localeStr: "en-US", n: 1234567, maxWidth: 2, options: []compactnumber.FormatterOption{compactnumber.SignificantDigits(2)}, expectedOut: "1M"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%d/%d", tt.localeStr, tt.n, tt.maxWidth), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.localeStr, compactnumber.Short, tt.options...)
+			out, err := formatter.FormatFit(tt.n, tt.maxWidth)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+
+	formatter := compactnumber.NewFormatter("en-US", compactnumber.Short)
+	if _, err := formatter.FormatFit(999, 2); err == nil {
+		t.Error("expected an error for a width nothing fits in")
+	}
+}
@@ -4,9 +4,12 @@ package compactnumber
 import (
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
-	"github.com/gotnospirit/makeplural/plural"
+	"github.com/nkall/compactnumber/internal/compact"
 	"github.com/nkall/compactnumber/internal/models"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
@@ -15,8 +18,33 @@ import (
 
 // Formatter is a struct containing a method to format an integer based on the specified language and compaction type.
 type Formatter struct {
-	lang        language.Tag
-	compactType CompactType
+	lang          language.Tag
+	compactType   CompactType
+	approximately bool
+	atLeast       bool
+	capped        bool
+	max           int
+	signDisplay   SignDisplay
+	threshold     int
+	roundingMode  RoundingMode
+
+	precise               bool
+	maxSignificantDigits  int
+	maxFractionDigits     int
+	compactRounding       bool
+	minimumGroupingDigits int
+	// FormatFit limits the fraction digits of the precision further to fit numbers in fewer columns
+	limitFractionDigits bool
+	fractionDigitsLimit int
+
+	compactNumOptions []number.Option
+	fullNumOptions    []number.Option
+
+	fallback      FallbackPolicy
+	defaultLocale language.Tag
+	onFallback    func(FallbackEvent)
+	// The locale tag the caller asked for, kept for OnFallback reports when lang fell back to the default locale
+	requested language.Tag
 }
 
 // FormatterAPI is an interface implemented by Formatter that can be used for mocking purposes
@@ -24,37 +52,252 @@ type FormatterAPI interface {
 	Format(n int, numOptions ...number.Option) (string, error)
 }
 
-// NewFormatter creates a new formatter based on the specified language and compaction type.
-func NewFormatter(lang string, compactType CompactType) Formatter {
-	return Formatter{
+// DefaultPrecision is the precision of formatters created without the Precision option. Set it to PrecisionCompact
+// before creating formatters to show numbers like ICU and browsers do everywhere, e.g. "1.2K" for 1234 in English.
+var DefaultPrecision = PrecisionInteger
+
+// NewFormatter creates a new formatter based on the specified language, compaction type and options.
+func NewFormatter(lang string, compactType CompactType, options ...FormatterOption) Formatter {
+	f := Formatter{
 		lang:        language.Make(lang),
 		compactType: compactType,
 	}
+	Precision(DefaultPrecision)(&f)
+	for _, option := range options {
+		option(&f)
+	}
+
+	if f.compactRounding && f.roundingMode == "" {
+		f.roundingMode = RoundHalfEven
+	}
+
+	return f
 }
 
 // Format takes in an integer and options and formats it according to the formatter's locale and compaction settings.
-// Note: this method truncates numbers and does not support fractions (e.g. 11.5M).
+// Note: by default, this method truncates compacted numbers to integers (e.g. 11M for 11.5M in English), see Round
+// and Precision.
+//
+// The number options apply to compacted numbers and to numbers formatted in full, after those of CompactNumberOptions
+// and FullNumberOptions. Options setting the precision, such as number.MaxFractionDigits(1) for "11.5M", round the
+// numbers instead of the formatter, so an error is returned if the formatter has a precision or a rounding mode.
 //
 // Documented in CLDR spec: http://www.unicode.org/reports/tr35/tr35-numbers.html#Compact_Number_Formats
 func (f *Formatter) Format(n int, numOptions ...number.Option) (string, error) {
-	numOptions = append(numOptions, number.Scale(0))
+	return f.format(n, 0, numOptions)
+}
 
-	compactForms, ok := compactFormsByLanguage[f.lang.String()]
-	if !ok {
-		// Fall back to base language
-		base, confidence := f.lang.Base()
-		if confidence == language.No {
-			return "", errors.New(fmt.Sprintf("no compact forms or fallback for language %s", f.lang.String()))
+// FormatAt formats an integer with the locale's compact form for the given magnitude, the type of one of its rules
+// (e.g. 1000000 for millions), regardless of the size of the number. The number is divided as it would be if it were
+// of that magnitude, so numbers that are too small for it get fractional mantissas: 500000 is "0.5M" at 1000000 in
+// English. Unlike Format, it does not truncate, and the precision can be set through the number options, e.g.
+// number.MinFractionDigits(1) for "12.0M". Numbers in the at-least pattern of AtLeast and Cap are truncated at that
+// precision instead, e.g. "99K+" rather than "100K+" for a cap of 99999 with number.MaxFractionDigits(0).
+func (f *Formatter) FormatAt(n int, magnitude int64, numOptions ...number.Option) (string, error) {
+	if magnitude <= 0 {
+		return "", errors.New(fmt.Sprintf("invalid magnitude %d", magnitude))
+	}
+
+	return f.format(n, magnitude, numOptions)
+}
+
+// Formats n at the magnitude, or at the magnitude of its rule if it is 0, with the formatter's options.
+func (f *Formatter) format(n int, magnitude int64, numOptions []number.Option) (string, error) {
+	parts, _, err := f.formatParts(n, magnitude, numOptions)
+	if err != nil {
+		return "", err
+	}
+
+	return joinParts(parts), nil
+}
+
+// Formats n like format, split into parts. The number they were formatted from is returned with them.
+func (f *Formatter) formatParts(n int, magnitude int64, numOptions []number.Option) ([]Part, compactNum, error) {
+	options, err := f.numberOptions(numOptions, magnitude == 0)
+	if err != nil {
+		return nil, compactNum{}, err
+	}
+
+	f, compactForms, err := f.compactForms()
+	if err != nil {
+		return nil, compactNum{}, err
+	}
+
+	formatted, err := f.formatNum(compactForms[f.compactType], n, magnitude, options)
+	if err != nil {
+		return nil, compactNum{}, err
+	}
+
+	// Capped numbers are already in the at-least pattern
+	parts := formatted.parts
+	if !formatted.capped {
+		prefix, suffix := splitPattern(f.miscPattern(), "{0}")
+		parts = wrapParts(parts, prefix, suffix)
+	}
+
+	return parts, formatted.num, nil
+}
+
+// formattedNum is a number formatted into parts, along with the number it was formatted from. Capped reports whether
+// it was capped and put in the at-least pattern, and signed whether its sign is shown with a plus sign or affixes.
+type formattedNum struct {
+	parts  []Part
+	num    compactNum
+	capped bool
+	signed bool
+}
+
+// Formats n, one of the numbers of the output, at the magnitude, or at the magnitude of its rule if it is 0: capped at
+// the formatter's maximum and put in the at-least pattern if it exceeds it, and with its sign shown as the formatter's
+// sign display calls for.
+func (f *Formatter) formatNum(compactForm []models.CompactFormRule, n int, magnitude int64, options numberOptions) (formattedNum, error) {
+	capped := f.capped && n > f.max
+	if capped {
+		n = f.max
+	}
+
+	// Numbers in the at-least pattern are truncated, as rounding them up would overstate them, e.g. 99999 as 100K+
+	if (capped || f.atLeast) && f.roundingMode != RoundDown {
+		truncating := *f
+		truncating.roundingMode = RoundDown
+		f = &truncating
+	}
+
+	var num compactNum
+	var err error
+	if magnitude == 0 {
+		num, err = f.compactNum(compactForm, n, options)
+	} else {
+		num, err = f.compactNumAt(compactForm, n, magnitude, options, capped || f.atLeast)
+	}
+	if err != nil {
+		return formattedNum{}, err
+	}
+
+	num, prefix, suffix := f.signDisplay.apply(num, lookupSigns(f.lang))
+	parts := wrapParts(num.parts(message.NewPrinter(f.lang), options), prefix, suffix)
+
+	if capped {
+		atLeastPrefix, atLeastSuffix := splitPattern(lookupMiscPatterns(f.lang).AtLeast, "{0}")
+		parts = wrapParts(parts, atLeastPrefix, atLeastSuffix)
+	}
+
+	return formattedNum{
+		parts:  parts,
+		num:    num,
+		capped: capped,
+		signed: prefix != "" || suffix != "" || num.plusSign != "",
+	}, nil
+}
+
+// Gets the pattern the output is wrapped in: the at-least or approximately pattern if the formatter calls for it.
+func (f *Formatter) miscPattern() string {
+	switch {
+	case f.atLeast:
+		return lookupMiscPatterns(f.lang).AtLeast
+	case f.approximately:
+		return lookupMiscPatterns(f.lang).Approximately
+	}
+
+	return "{0}"
+}
+
+// FormatRange formats a range of integers according to the formatter's locale and compaction settings, e.g. 1000 to
+// 5000 is "1K–5K" in English. If both ends use the same pattern and its affixes are longer than a single character,
+// they are only shown once, with the plural form of the whole range: "1–5 million". A range whose ends format the
+// same is formatted as a single number in the approximately pattern, as in ICU: 5000 to 5500 is "~5K".
+//
+// Each end is capped and shows its sign as Format would, e.g. -5000 to -1000 is "(5K)–(1K)" with SignAccounting.
+// The affixes of ends that are capped or show their sign with a plus sign or affixes are not collapsed. Approximately
+// and AtLeast wrap the whole range, e.g. "~1K–5K", unless an end is capped.
+//
+// Documented in CLDR spec: http://www.unicode.org/reports/tr35/tr35-numbers.html#Miscellaneous_Patterns
+func (f *Formatter) FormatRange(lo, hi int, numOptions ...number.Option) (string, error) {
+	options, err := f.numberOptions(numOptions, true)
+	if err != nil {
+		return "", err
+	}
+
+	f, compactForms, err := f.compactForms()
+	if err != nil {
+		return "", err
+	}
+
+	loEnd, err := f.formatNum(compactForms[f.compactType], lo, 0, options)
+	if err != nil {
+		return "", err
+	}
+
+	hiEnd, err := f.formatNum(compactForms[f.compactType], hi, 0, options)
+	if err != nil {
+		return "", err
+	}
+
+	baseNumPrinter := message.NewPrinter(f.lang)
+	miscPatterns := lookupMiscPatterns(f.lang)
+	rangePattern := miscPatterns.Range
+
+	// Capped ends are already in the at-least pattern, so the range is only wrapped in the formatter's pattern without
+	// them. Ends that format the same are shown once, in the approximately pattern if it has none.
+	miscPattern := f.miscPattern()
+	if loEnd.capped || hiEnd.capped {
+		miscPattern = "{0}"
+	}
+	identityPattern := miscPattern
+	if identityPattern == "{0}" && !loEnd.capped {
+		identityPattern = miscPatterns.Approximately
+	}
+
+	// Collapse the affixes if both ends share them, as long as doing so doesn't make the range ambiguous
+	loNum, hiNum := loEnd.num, hiEnd.num
+	collapsible := !loEnd.capped && !hiEnd.capped && !loEnd.signed && !hiEnd.signed
+	if collapsible && loNum.negativeModifier == hiNum.negativeModifier && loNum.rule.Type == hiNum.rule.Type && loNum.pattern != "0" && hiNum.pattern != "0" {
+		pluralForm := compact.PluralRangeForm(lookupPluralRanges(f.lang), loNum.pluralForm, hiNum.pluralForm)
+		pattern, err := f.pattern(loNum.rule, pluralForm)
+		if err != nil {
+			return "", err
 		}
 
-		compactForms, ok = compactFormsByLanguage[base.String()]
-		if !ok {
-			return "", errors.New(fmt.Sprintf("missing compact forms for language %s and fallback %s", f.lang.String(), base))
+		affixes := strings.TrimSpace(strings.Replace(pattern, "%v", "", 1))
+		if utf8.RuneCountInString(affixes) > 1 {
+			if loNum.shortN == hiNum.shortN {
+				return strings.Replace(identityPattern, "{0}", joinParts(loEnd.parts), 1), nil
+			}
+
+			numbers := strings.NewReplacer(
+				"{0}", baseNumPrinter.Sprint(number.Decimal(loNum.shortN, loNum.numOptions(options)...)),
+				"{1}", baseNumPrinter.Sprint(number.Decimal(hiNum.shortN, hiNum.numOptions(options)...)),
+			).Replace(rangePattern)
+			return strings.Replace(miscPattern, "{0}", baseNumPrinter.Sprintf(pattern, numbers), 1), nil
 		}
 	}
 
-	compactForm := compactForms[f.compactType]
+	loOut, hiOut := joinParts(loEnd.parts), joinParts(hiEnd.parts)
+	if loOut == hiOut {
+		return strings.Replace(identityPattern, "{0}", loOut, 1), nil
+	}
+
+	return strings.Replace(miscPattern, "{0}", strings.NewReplacer("{0}", loOut, "{1}", hiOut).Replace(rangePattern), 1), nil
+}
 
+// compactNum is a number along with the rule, plural form and pattern it is formatted with.
+type compactNum struct {
+	n                int
+	negativeModifier int
+	shortN           float64
+	rule             models.CompactFormRule
+	pluralForm       string
+	pattern          string
+	plusSign         string
+	noSeparator      bool
+	fractionDigits   int
+	// The number the absolute value was divided by, and the mantissa the plural form was selected from
+	divisor int64
+	decimal string
+}
+
+// Prepares n for formatting with one of the compact form rules.
+func (f *Formatter) compactNum(compactForm []models.CompactFormRule, n int, options numberOptions) (compactNum, error) {
 	// Apply negative modifier at the end if dealing with negative number
 	negativeModifier := 1
 	if n < 0 {
@@ -62,103 +305,414 @@ func (f *Formatter) Format(n int, numOptions ...number.Option) (string, error) {
 		n *= -1
 	}
 
+	// Numbers below the threshold are not compacted, as if the locale had a "0" pattern for them. Those with too few
+	// digits to be grouped as per the locale's minimum grouping digits lose their grouping separators.
+	noSeparator := false
+	if n < f.threshold {
+		compactForm = nil
+		noSeparator = digits(n) < primaryGroupingSize+lookupMinimumGroupingDigits(f.lang)
+	}
+
+	// Number options setting the precision round the number instead of the formatter
+	if compacted := compacts(compact.SelectRule(compactForm, int64(n))); compacted && options.compactPrecision || !compacted && options.fullPrecision {
+		return f.optionsCompactNum(compactForm, n, negativeModifier, noSeparator, options)
+	}
+
+	if f.precise {
+		return f.preciseCompactNum(compactForm, n, negativeModifier, noSeparator)
+	}
+
 	// To format a number N, the greatest type less than or equal to N is used, with the appropriate plural category.
+	rule := compact.SelectRule(compactForm, int64(n))
+
+	// N is divided by the type, after removing the number of zeros in the pattern, less 1.
+	shortN := f.shortNum(n, rule, negativeModifier < 0)
+
+	// Rounding up may reach the type of the next rule, e.g. 999999 to 1000K, which is formatted with that rule instead
+	if divisor := compact.Divisor(rule); divisor != 0 {
+		if next := compact.SelectRule(compactForm, shortN*divisor); next.Type != rule.Type {
+			rule = next
+			shortN = f.shortNum(int(shortN*divisor), rule, negativeModifier < 0)
+		}
+	}
+
+	// Best effort fetching plural form
+	plurForm := f.pluralForm(shortN)
+
+	pattern, err := f.pattern(rule, plurForm)
+	if err != nil {
+		return compactNum{}, err
+	}
+
+	return compactNum{
+		n:                n * negativeModifier,
+		negativeModifier: negativeModifier,
+		shortN:           float64(shortN * int64(negativeModifier)),
+		rule:             rule,
+		pluralForm:       plurForm,
+		pattern:          pattern,
+		noSeparator:      noSeparator,
+		divisor:          divisorOf(rule),
+		decimal:          strconv.FormatInt(shortN, 10),
+	}, nil
+}
+
+// Prepares n, an absolute value, for formatting with one of the compact form rules, rounded to the formatter's
+// precision. Numbers that are not compacted are rounded too, e.g. 1234 is 1,200 with 2 significant digits.
+func (f *Formatter) preciseCompactNum(compactForm []models.CompactFormRule, n int, negativeModifier int, noSeparator bool) (compactNum, error) {
+	rule, rounded, fractionDigits := f.round(compactForm, int64(n), negativeModifier < 0)
+
+	// Numbers formatted in full have no fraction digits, so their plural form is the one of the whole number
+	shortN, divisor, decimal := float64(rounded), int64(1), strconv.FormatInt(rounded, 10)
+	if compacts(rule) {
+		divisor = compact.Divisor(rule)
+		shortN = float64(rounded) / float64(divisor)
+		decimal = strconv.FormatFloat(shortN, 'f', -1, 64)
+	}
+	plurForm := f.pluralForm(decimal)
+
+	pattern, err := f.pattern(rule, plurForm)
+	if err != nil {
+		return compactNum{}, err
+	}
+
+	return compactNum{
+		n:                int(rounded) * negativeModifier,
+		negativeModifier: negativeModifier,
+		shortN:           shortN * float64(negativeModifier),
+		rule:             rule,
+		pluralForm:       plurForm,
+		pattern:          pattern,
+		noSeparator:      noSeparator || f.minimumGroupingDigits > 0 && digits(int(shortN)) < primaryGroupingSize+f.minimumGroupingDigits,
+		fractionDigits:   fractionDigits,
+		divisor:          divisor,
+		decimal:          decimal,
+	}, nil
+}
+
+// Rounds n, an absolute value, to the formatter's precision, returning the compact form rule to format the rounded
+// number with and the number of fraction digits of its mantissa. Negative reports whether the number is negative.
+func (f *Formatter) round(compactForm []models.CompactFormRule, n int64, negative bool) (models.CompactFormRule, int64, int) {
+	for {
+		rule := compact.SelectRule(compactForm, n)
+
+		// The mantissa of numbers formatted in full is the number itself
+		divisor := int64(1)
+		if compacts(rule) {
+			divisor = compact.Divisor(rule)
+		}
+
+		integerDigits := digits(int(n / divisor))
+		fractionDigits, integerUnit := f.maxFractionDigits, int64(1)
+		switch {
+		case f.maxSignificantDigits > 0 && integerDigits > f.maxSignificantDigits:
+			fractionDigits, integerUnit = 0, pow10(integerDigits-f.maxSignificantDigits)
+		case f.maxSignificantDigits > 0:
+			fractionDigits = f.maxSignificantDigits - integerDigits
+		case f.compactRounding:
+			// Two significant digits, but no rounding of the integer part
+			fractionDigits = 0
+			if integerDigits < 2 {
+				fractionDigits = 2 - integerDigits
+			}
+		}
+
+		if f.limitFractionDigits && fractionDigits > f.fractionDigitsLimit {
+			fractionDigits = f.fractionDigitsLimit
+		}
+
+		// Digits beyond the divisor's are zeroes, so they are not shown
+		if maxFractionDigits := digits(int(divisor)) - 1; fractionDigits > maxFractionDigits {
+			fractionDigits = maxFractionDigits
+		}
+
+		unit := divisor / pow10(fractionDigits) * integerUnit
+		rounded := f.roundingMode.divide(n, unit, negative) * unit
+
+		// Rounding up may reach the type of the next rule, e.g. 999999 to 1000K, which is rounded again with that rule
+		if compact.SelectRule(compactForm, rounded).Type == rule.Type {
+			return rule, rounded, fractionDigits
+		}
+		n = rounded
+	}
+}
+
+// Prepares n for formatting with the compact form rule of the magnitude. Truncate reports whether the mantissa is
+// truncated at the precision of the number options, as it is in the at-least pattern.
+func (f *Formatter) compactNumAt(compactForm []models.CompactFormRule, n int, magnitude int64, options numberOptions, truncate bool) (compactNum, error) {
 	var rule models.CompactFormRule
 	for _, compactFormRule := range compactForm {
-		if int64(n) >= compactFormRule.Type {
+		if compactFormRule.Type == magnitude {
 			rule = compactFormRule
-		} else {
-			break
 		}
 	}
+	if rule.Type == 0 {
+		return compactNum{}, errors.New(fmt.Sprintf("no %s compact form for magnitude %d in language %s", f.compactType, magnitude, f.lang.String()))
+	}
 
-	// N is divided by the type, after removing the number of zeros in the pattern, less 1.
-	shortN := f.shortNum(n, rule)
+	negativeModifier := 1
+	if n < 0 {
+		negativeModifier = -1
+	}
 
-	// Best effort fetching plural form
-	plurForm := f.pluralForm(shortN)
+	// The mantissa may be fractional, so the plural form is selected from its decimal representation
+	shortN := float64(n) / float64(compact.Divisor(rule))
+	decimal := strconv.FormatFloat(math.Abs(shortN), 'f', -1, 64)
+	if truncate {
+		truncatedDecimal, absShortN, err := truncated(math.Abs(shortN), options.compact)
+		if err != nil {
+			return compactNum{}, err
+		}
+		decimal, shortN = truncatedDecimal, absShortN*float64(negativeModifier)
+	}
+	plurForm := f.pluralForm(decimal)
 
-	pattern, ok := rule.PatternsByPluralForm[plurForm]
+	pattern, err := f.pattern(rule, plurForm)
+	if err != nil {
+		return compactNum{}, err
+	}
+
+	return compactNum{
+		n:                n,
+		negativeModifier: negativeModifier,
+		shortN:           shortN,
+		rule:             rule,
+		pluralForm:       plurForm,
+		pattern:          pattern,
+		divisor:          compact.Divisor(rule),
+		decimal:          decimal,
+	}, nil
+}
+
+func (c compactNum) format(baseNumPrinter *message.Printer, options numberOptions) string {
+	return joinParts(c.parts(baseNumPrinter, options))
+}
+
+func (c compactNum) parts(baseNumPrinter *message.Printer, options numberOptions) []Part {
+	numOptions := c.numOptions(options)
+
+	// If the value is precisely “0”, either explicit or defaulted, then the normal number format pattern for that sort of object is supplied
+	if c.pattern == "0" {
+		return append(signParts(c.plusSign), numberParts(baseNumPrinter, number.Decimal(c.n, numOptions...))...)
+	}
+
+	// The plus sign goes where golang.org/x/text puts the minus sign, right before the number
+	prefix, suffix := splitPattern(c.pattern, "%v")
+	parts := compactParts(prefix)
+	parts = append(parts, signParts(c.plusSign)...)
+	parts = append(parts, numberParts(baseNumPrinter, number.Decimal(c.shortN, numOptions...))...)
+	return append(parts, compactParts(suffix)...)
+}
+
+// Returns the number options the number is formatted with: those of numbers formatted in full, or those of compacted
+// numbers showing the fraction digits the mantissa was rounded to.
+func (c compactNum) numOptions(options numberOptions) []number.Option {
+	numOptions := options.compact
+	if c.pattern == "0" {
+		numOptions = options.full
+	} else if c.fractionDigits > 0 {
+		numOptions = append(numOptions[:len(numOptions):len(numOptions)], number.MaxFractionDigits(c.fractionDigits))
+	}
+	if c.noSeparator {
+		numOptions = append(numOptions[:len(numOptions):len(numOptions)], number.NoSeparator())
+	}
+
+	return numOptions
+}
+
+// Returns the number without its sign.
+func (c compactNum) abs() compactNum {
+	if c.negativeModifier < 0 {
+		c.n, c.shortN, c.negativeModifier = -c.n, -c.shortN, 1
+	}
+
+	return c
+}
+
+// Gets the number a rule divides numbers by, or 1 for the zero rule, which doesn't divide them.
+func divisorOf(rule models.CompactFormRule) int64 {
+	if divisor := compact.Divisor(rule); divisor != 0 {
+		return divisor
+	}
+
+	return 1
+}
+
+// Reports whether a rule compacts numbers, rather than formatting them in full.
+func compacts(rule models.CompactFormRule) bool {
+	return rule.Type != 0 && rule.PatternsByPluralForm["other"] != "0"
+}
+
+// Gets the pattern of a rule for a plural form.
+func patternFor(rule models.CompactFormRule, pluralForm string) string {
+	pattern, ok := rule.PatternsByPluralForm[pluralForm]
 	if !ok {
 		// Attempt to fall back to catch-all "other" pattern if none for current plural form found
 		pattern = rule.PatternsByPluralForm["other"]
 	}
 
-	var err error
-	pattern, err = formatPattern(pattern)
-	if err != nil {
-		return "", err
+	return pattern
+}
+
+// Gets the pattern of a rule for a plural form, processed for Printer.Sprintf. With FallbackStrict, a plural form
+// without a pattern is an error rather than falling back to "other".
+func (f *Formatter) pattern(rule models.CompactFormRule, pluralForm string) (string, error) {
+	if _, ok := rule.PatternsByPluralForm[pluralForm]; !ok && compacts(rule) {
+		if f.fallback == FallbackStrict {
+			return "", &PluralError{Locale: f.lang, Type: rule.Type, Category: PluralCategory(pluralForm)}
+		}
+		f.reportFallback(ReasonPluralOther, rule, pluralForm)
 	}
 
-	// If the value is precisely “0”, either explicit or defaulted, then the normal number format pattern for that sort of object is supplied
-	baseNumPrinter := message.NewPrinter(f.lang)
-	if pattern == "0" {
-		return baseNumPrinter.Sprintf("%v", number.Decimal(n*negativeModifier, numOptions...)), nil
+	cldrPattern := patternFor(rule, pluralForm)
+	pattern, err := compact.SprintfPattern(cldrPattern)
+	if err != nil {
+		return "", &PatternError{Locale: f.lang, Pattern: cldrPattern, Err: err}
+	}
+	if pattern == "0" && rule.Type != 0 {
+		f.reportFallback(ReasonUncompacted, rule, "")
 	}
 
-	return baseNumPrinter.Sprintf(pattern, number.Decimal(shortN*int64(negativeModifier), numOptions...)), nil
+	return pattern, nil
 }
 
-// Divides number to be used in compact display according to logic in CLDR spec: http://www.unicode.org/reports/tr35/tr35-numbers.html#Compact_Number_Formats
-func (f *Formatter) shortNum(n int, rule models.CompactFormRule) int64 {
-	typeDivisor := rule.Type
-	for i := 0; i < rule.ZeroesInPattern-1; i++ {
-		typeDivisor /= 10
+// Looks up the compact forms of the formatter's locale, applying its fallback policy if there are none. The formatter
+// to format with is returned with them: a copy in the default locale with FallbackLocale. With FallbackNone, there are
+// no compact forms, so numbers are formatted in full.
+func (f *Formatter) compactForms() (*Formatter, map[CompactType][]models.CompactFormRule, error) {
+	compactForms, err := lookupCompactForms(f.lang)
+	if err == nil {
+		return f, compactForms, nil
 	}
 
-	outNum := int64(n)
-	if typeDivisor != 0 {
-		outNum /= typeDivisor
+	switch f.fallback {
+	case FallbackLocale:
+		defaultLocale := f.defaultLocale
+		if defaultLocale == language.Und {
+			defaultLocale = language.English
+		}
+
+		if compactForms, err = lookupCompactForms(defaultLocale); err != nil {
+			return nil, nil, &LocaleError{Requested: f.lang, Fallback: defaultLocale}
+		}
+
+		fallback := *f
+		fallback.lang, fallback.requested = defaultLocale, f.lang
+		fallback.reportFallback(ReasonDefaultLocale, models.CompactFormRule{}, "")
+		return &fallback, compactForms, nil
+	case FallbackNone:
+		f.reportFallback(ReasonNoCompactForms, models.CompactFormRule{}, "")
+		return f, nil, nil
 	}
 
-	return outNum
+	return nil, nil, err
 }
 
-// Gets the pluralized form of the number, as per CLDR spec: http://cldr.unicode.org/index/cldr-spec/plural-rules
-// We use gotnospirit/makeplural for this as golang.org/x/text/plural does not expose a suitable PluralForm method.
-// This is a best effort function since the languages might not match up perfectly between packages.
-func (f *Formatter) pluralForm(n interface{}) string {
-	base, confidence := f.lang.Base()
+// Looks up the compact forms of a language, falling back to less specific tags of it if there are none for the exact
+// tag, see compact.DataKeys.
+func lookupCompactForms(lang language.Tag) (map[CompactType][]models.CompactFormRule, error) {
+	for _, key := range compact.DataKeys(lang) {
+		if compactForms, ok := compactFormsByLanguage[key]; ok {
+			return compactForms, nil
+		}
+	}
+
+	base, confidence := lang.Base()
 	if confidence == language.No {
-		return "other"
+		return nil, &LocaleError{Requested: lang, Fallback: language.Und}
 	}
 
-	plurFunc, err := plural.GetFunc(base.String())
-	if err != nil {
-		return "other"
+	return nil, &LocaleError{Requested: lang, Fallback: language.Make(base.String())}
+}
+
+// Looks up the misc patterns of a language, falling back to less specific tags of it and then to the CLDR root
+// patterns.
+func lookupMiscPatterns(lang language.Tag) models.MiscPatterns {
+	for _, key := range compact.DataKeys(lang) {
+		if patterns, ok := miscPatternsByLanguage[key]; ok {
+			return patterns
+		}
 	}
 
-	return plurFunc(n, false)
+	return miscPatternsByLanguage["root"]
 }
 
-// Process CLDR pattern to a format suitable for use in Printer.Sprintf in golang.org/x/text/message.
-// Documentation for these special characters can be found in the CLDR spec: http://cldr.unicode.org/translation/number-patterns
-func formatPattern(pattern string) (string, error) {
-	// Default to 0 (sentinel value for no pattern)
-	if pattern == "" {
-		return "0", nil
+// Looks up the signs of a language, falling back to less specific tags of it and then to the CLDR root signs.
+func lookupSigns(lang language.Tag) models.Signs {
+	for _, key := range compact.DataKeys(lang) {
+		if signs, ok := signsByLanguage[key]; ok {
+			return signs
+		}
 	}
 
-	// Default to the first form if there's multiple
-	pattern = strings.Split(pattern, ";")[0]
+	return signsByLanguage["root"]
+}
 
-	// Remove special pattern symbols, as this formatting is already handled by golang.org/x/text/message
-	pattern = strings.Replace(pattern, "'", "", -1)
+// primaryGroupingSize is the number of digits in the first group of a number. While some locales use a different size
+// for the following groups, every locale in CLDR groups the first three digits.
+const primaryGroupingSize = 3
 
-	// Replace all 0s with a single %v for number formatting
-	zeroIndex := strings.IndexRune(pattern, '0')
-	if zeroIndex == -1 {
-		return "", errors.New(fmt.Sprintf("invalid pattern (no digit pattern characters): %s", pattern))
+// Looks up the minimum grouping digits of a language, falling back to less specific tags of it and then to the CLDR
+// root.
+func lookupMinimumGroupingDigits(lang language.Tag) int {
+	for _, key := range compact.DataKeys(lang) {
+		if minimumGroupingDigits, ok := minimumGroupingDigitsByLanguage[key]; ok {
+			return minimumGroupingDigits
+		}
 	}
 
-	pattern = strings.Replace(pattern, "0", "", -1)
-	patternRunes := []rune(pattern)
+	return minimumGroupingDigitsByLanguage["root"]
+}
 
-	endStr := ""
-	if zeroIndex < len(patternRunes) {
-		endStr = string(patternRunes[zeroIndex:])
+// Raises 10 to a non-negative exponent.
+func pow10(exponent int) int64 {
+	result := int64(1)
+	for i := 0; i < exponent; i++ {
+		result *= 10
 	}
 
-	pattern = fmt.Sprintf("%s%s%s", string(patternRunes[:zeroIndex]), "%v", endStr)
-	return pattern, nil
+	return result
+}
+
+// Counts the digits of a non-negative number.
+func digits(n int) int {
+	count := 1
+	for n >= 10 {
+		n /= 10
+		count++
+	}
+
+	return count
+}
+
+// Looks up the plural ranges of a language. Plural ranges are defined per base language.
+func lookupPluralRanges(lang language.Tag) models.PluralRanges {
+	base, confidence := lang.Base()
+	if confidence == language.No {
+		return nil
+	}
+
+	return pluralRangesByLanguage[base.String()]
+}
+
+// Divides number to be used in compact display according to logic in CLDR spec: http://www.unicode.org/reports/tr35/tr35-numbers.html#Compact_Number_Formats
+func (f *Formatter) shortNum(n int, rule models.CompactFormRule, negative bool) int64 {
+	typeDivisor := compact.Divisor(rule)
+
+	outNum := int64(n)
+	if typeDivisor != 0 {
+		outNum = f.roundingMode.divide(outNum, typeDivisor, negative)
+	}
+
+	return outNum
+}
+
+// Gets the pluralized form of the number, as per CLDR spec: http://cldr.unicode.org/index/cldr-spec/plural-rules
+func (f *Formatter) pluralForm(n interface{}) string {
+	pluralForm, ok := compact.LookupPluralForm(f.lang, n)
+	if !ok {
+		f.reportFallback(ReasonNoPluralRules, models.CompactFormRule{}, pluralForm)
+	}
+
+	return pluralForm
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/nkall/compactnumber"
+	"golang.org/x/text/number"
 )
 
 func TestFormatterFormatAmericanEnglishShort(t *testing.T) {
@@ -116,6 +117,27 @@ func TestFormatterFormatRussian(t *testing.T) {
 	mustMatch(t, out, err, "9 триллионов", nil)
 }
 
+func TestFormatterFormatUncompactedPattern(t *testing.T) {
+	// German has no short form for thousands, so its "0" pattern formats the whole number
+	formatter := compactnumber.NewFormatter("de", compactnumber.Short)
+
+	out, err := formatter.Format(1499)
+	mustMatch(t, out, err, "1.499", nil)
+
+	out, err = formatter.Format(-99999)
+	mustMatch(t, out, err, "-99.999", nil)
+
+	out, err = formatter.Format(1499999)
+	mustMatch(t, out, err, "1 Mio.", nil)
+}
+
+func TestFormatterFormatNonASCIIPrefix(t *testing.T) {
+	formatter := compactnumber.NewFormatter("sw", compactnumber.Short)
+
+	out, err := formatter.Format(2499)
+	mustMatch(t, out, err, "elfu 2", nil)
+}
+
 func TestFormatterFormatVariousMajorLanguages(t *testing.T) {
 	tests := []struct {
 		localeStr   string
@@ -146,7 +168,7 @@ func TestFormatterFormatVariousMajorLanguages(t *testing.T) {
 		{localeStr: "th-TH", expectedOut: "-69M"},
 		{localeStr: "tr-TR", expectedOut: "-69 Mn"},
 		{localeStr: "vi-VN", expectedOut: "-69 Tr"},
-		{localeStr: "zh-CN", expectedOut: "-6,954萬"},
+		{localeStr: "zh-CN", expectedOut: "-6,954万"},
 		{localeStr: "zh-TW", expectedOut: "-6,954萬"},
 	}
 	for _, tt := range tests {
@@ -158,6 +180,111 @@ func TestFormatterFormatVariousMajorLanguages(t *testing.T) {
 	}
 }
 
+func TestFormatterFormatRange(t *testing.T) {
+	tests := []struct {
+		localeStr   string
+		compactType compactnumber.CompactType
+		lo          int
+		hi          int
+		options     []compactnumber.FormatterOption
+		expectedOut string
+	}{
+		// Single character affixes are repeated
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 1000, hi: 5000, expectedOut: "1K–5K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 999, hi: 5000000, expectedOut: "999–5M"},
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: -5000, hi: 5000, expectedOut: "-5K–5K"},
+		{localeStr: "ja", compactType: compactnumber.Short, lo: 10000, hi: 50000, expectedOut: "1万～5万"},
+		// Longer affixes are collapsed
+		{localeStr: "en-US", compactType: compactnumber.Long, lo: 1000000, hi: 5000000, expectedOut: "1–5 million"},
+		{localeStr: "en-US", compactType: compactnumber.Long, lo: 1000, hi: 5000000, expectedOut: "1 thousand–5 million"},
+		{localeStr: "de", compactType: compactnumber.Short, lo: 1000000, hi: 5000000, expectedOut: "1–5 Mio."},
+		{localeStr: "es", compactType: compactnumber.Short, lo: 1000, hi: 5000, expectedOut: "1-5 mil"},
+		// Collapsed affixes use the plural form of the range
+		{localeStr: "ru", compactType: compactnumber.Long, lo: 1000000, hi: 5000000, expectedOut: "1–5 миллионов"},
+		{localeStr: "ru", compactType: compactnumber.Long, lo: 1000000, hi: 2000000, expectedOut: "1–2 миллиона"},
+		// Uncompacted patterns are never collapsed
+		{localeStr: "de", compactType: compactnumber.Short, lo: 1000, hi: 5000, expectedOut: "1.000–5.000"},
+		// Ranges whose ends format the same are formatted once, in the approximately pattern
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 5000, hi: 5500, expectedOut: "~5K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 1000, hi: 1000, expectedOut: "~1K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 3, hi: 3, expectedOut: "~3"},
+		{localeStr: "en-US", compactType: compactnumber.Long, lo: 1000, hi: 1000, expectedOut: "~1 thousand"},
+		{localeStr: "fr", compactType: compactnumber.Long, lo: 1000000, hi: 1500000, expectedOut: "≃1 million"},
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 5000, hi: 5500, options: []compactnumber.FormatterOption{compactnumber.Approximately()}, expectedOut: "~5K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 5000, hi: 5500, options: []compactnumber.FormatterOption{compactnumber.AtLeast()}, expectedOut: "5K+"},
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 200000, hi: 300000, options: []compactnumber.FormatterOption{compactnumber.Cap(99999)}, expectedOut: "99K+"},
+		// Approximately and AtLeast wrap the whole range
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 1000, hi: 5000, options: []compactnumber.FormatterOption{compactnumber.Approximately()}, expectedOut: "~1K–5K"},
+		{localeStr: "en-US", compactType: compactnumber.Long, lo: 1000000, hi: 5000000, options: []compactnumber.FormatterOption{compactnumber.Approximately()}, expectedOut: "~1–5 million"},
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 1000, hi: 5999, options: []compactnumber.FormatterOption{compactnumber.AtLeast(), compactnumber.Round(compactnumber.RoundHalfUp)}, expectedOut: "1K–5K+"},
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 1000, hi: 500000, options: []compactnumber.FormatterOption{compactnumber.Approximately(), compactnumber.Cap(99999)}, expectedOut: "1K–99K+"},
+		// Both ends are rounded to the precision of the formatter, collapsed or not
+		{localeStr: "en-US", compactType: compactnumber.Long, lo: 1200000, hi: 5500000, options: []compactnumber.FormatterOption{compactnumber.Precision(compactnumber.PrecisionCompact)}, expectedOut: "1.2–5.5 million"},
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 1200000, hi: 5500000, options: []compactnumber.FormatterOption{compactnumber.Precision(compactnumber.PrecisionCompact)}, expectedOut: "1.2M–5.5M"},
+		{localeStr: "en-US", compactType: compactnumber.Long, lo: 1234, hi: 5678, options: []compactnumber.FormatterOption{compactnumber.SignificantDigits(3)}, expectedOut: "1.23–5.67 thousand"},
+		{localeStr: "de", compactType: compactnumber.Short, lo: 1234000, hi: 5678000, options: []compactnumber.FormatterOption{compactnumber.SignificantDigits(3)}, expectedOut: "1,23–5,67 Mio."},
+		{localeStr: "en-US", compactType: compactnumber.Long, lo: 1000, hi: 1040, options: []compactnumber.FormatterOption{compactnumber.SignificantDigits(3)}, expectedOut: "1–1.04 thousand"},
+		{localeStr: "en-US", compactType: compactnumber.Long, lo: 1234000, hi: 5678000, options: []compactnumber.FormatterOption{compactnumber.FractionDigits(1)}, expectedOut: "1.2–5.6 million"},
+		// Each end is capped and shows its sign as Format would, without collapsing
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: -5000, hi: -1000, options: []compactnumber.FormatterOption{compactnumber.DisplaySign(compactnumber.SignAccounting)}, expectedOut: "(5K)–(1K)"},
+		{localeStr: "en-US", compactType: compactnumber.Long, lo: -5000000, hi: -1000000, options: []compactnumber.FormatterOption{compactnumber.DisplaySign(compactnumber.SignAccounting)}, expectedOut: "(5 million)–(1 million)"},
+		{localeStr: "en-US", compactType: compactnumber.Long, lo: 1000000, hi: 5000000, options: []compactnumber.FormatterOption{compactnumber.DisplaySign(compactnumber.SignAlways)}, expectedOut: "+1 million–+5 million"},
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 1000, hi: 5000, options: []compactnumber.FormatterOption{compactnumber.DisplaySign(compactnumber.SignNever)}, expectedOut: "1K–5K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, lo: 1000, hi: 500000, options: []compactnumber.FormatterOption{compactnumber.Cap(99999)}, expectedOut: "1K–99K+"},
+		{localeStr: "en-US", compactType: compactnumber.Long, lo: 1000, hi: 500000, options: []compactnumber.FormatterOption{compactnumber.Cap(99999)}, expectedOut: "1 thousand–99 thousand+"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%d-%d", tt.localeStr, tt.compactType, tt.lo, tt.hi), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.localeStr, tt.compactType, tt.options...)
+			out, err := formatter.FormatRange(tt.lo, tt.hi)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+}
+
+func TestFormatterFormatAt(t *testing.T) {
+	fractionDigits := []number.Option{number.MinFractionDigits(1), number.MaxFractionDigits(1)}
+	tests := []struct {
+		localeStr   string
+		compactType compactnumber.CompactType
+		n           int
+		magnitude   int64
+		options     []compactnumber.FormatterOption
+		numOptions  []number.Option
+		expectedOut string
+	}{
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 500000, magnitude: 1000000, numOptions: fractionDigits, expectedOut: "0.5M"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 12000000, magnitude: 1000000, numOptions: fractionDigits, expectedOut: "12.0M"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 240300000, magnitude: 1000000, numOptions: fractionDigits, expectedOut: "240.3M"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: -1234567, magnitude: 1000000, numOptions: fractionDigits, expectedOut: "-1.2M"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 1234567, magnitude: 1000, expectedOut: "1,234.567K"},
+		{localeStr: "en-US", compactType: compactnumber.Long, n: 2500000, magnitude: 1000000, expectedOut: "2.5 million"},
+		// The plural form is selected for the fractional mantissa
+		{localeStr: "ru", compactType: compactnumber.Long, n: 1500000, magnitude: 1000000, expectedOut: "1,5 миллиона"},
+		{localeStr: "ru", compactType: compactnumber.Long, n: 5000000, magnitude: 1000000, expectedOut: "5 миллионов"},
+		// Magnitudes the locale doesn't compact format the whole number
+		{localeStr: "de", compactType: compactnumber.Short, n: 1500, magnitude: 1000, expectedOut: "1.500"},
+		// The at-least pattern truncates the mantissa at the precision of the number options
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 150000, magnitude: 1000, options: []compactnumber.FormatterOption{compactnumber.Cap(99999)}, numOptions: []number.Option{number.MaxFractionDigits(0)}, expectedOut: "99K+"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 150000, magnitude: 1000, options: []compactnumber.FormatterOption{compactnumber.Cap(99999)}, numOptions: fractionDigits, expectedOut: "99.9K+"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 150000, magnitude: 1000, options: []compactnumber.FormatterOption{compactnumber.Cap(99999)}, expectedOut: "99.999K+"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 99999, magnitude: 1000, options: []compactnumber.FormatterOption{compactnumber.AtLeast()}, numOptions: []number.Option{number.MaxFractionDigits(0)}, expectedOut: "99K+"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: -99999, magnitude: 1000, options: []compactnumber.FormatterOption{compactnumber.AtLeast()}, numOptions: []number.Option{number.Precision(2)}, expectedOut: "-99K+"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%d@%d", tt.localeStr, tt.compactType, tt.n, tt.magnitude), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.localeStr, tt.compactType, tt.options...)
+			out, err := formatter.FormatAt(tt.n, tt.magnitude, tt.numOptions...)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+
+	formatter := compactnumber.NewFormatter("en-US", compactnumber.Short)
+	if _, err := formatter.FormatAt(5000, 1234); err == nil {
+		t.Error("expected an error for a magnitude without a compact form")
+	}
+}
+
 func mustMatch(t *testing.T, out string, err error, expectedOut string, expectedErr error) {
 	if err != expectedErr {
 		t.Error(fmt.Sprintf("got unexpected error %v (wanted %v)", err, expectedErr))
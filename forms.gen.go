@@ -1,4 +1,4 @@
-// Code generated by https://github.com/nkall/compactnumber at 2019-10-20 13:43:16.072781 -0700 PDT m=+0.107571706. DO NOT EDIT.
+// Code generated by https://github.com/nkall/compactnumber. DO NOT EDIT.
 // Based on https://github.com/unicode-cldr/cldr-numbers-modern version 36
 //
 // Do not edit this file manually! Instead, follow the "Generating Compact Forms" guide in the README.
@@ -6,6 +6,9 @@ package compactnumber
 
 import "github.com/nkall/compactnumber/internal/models"
 
+// cldrVersion is the version of the CLDR data the compact forms were extracted from.
+const cldrVersion = "36"
+
 var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule{
 	"af": map[CompactType][]models.CompactFormRule{
 		Long: {
@@ -61965,99 +61968,99 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 hiljade",
-					"one":   "0 hiljada",
-					"other": "0 hiljada",
+					"few":   "0 хиљаде",
+					"one":   "0 хиљада",
+					"other": "0 хиљада",
 				},
 			},
 			{
 				Type:            10000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 hiljade",
-					"one":   "00 hiljada",
-					"other": "00 hiljada",
+					"few":   "00 хиљаде",
+					"one":   "00 хиљада",
+					"other": "00 хиљада",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 hiljade",
-					"one":   "000 hiljada",
-					"other": "000 hiljada",
+					"few":   "000 хиљаде",
+					"one":   "000 хиљада",
+					"other": "000 хиљада",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 miliona",
-					"one":   "0 milion",
-					"other": "0 miliona",
+					"few":   "0 милиона",
+					"one":   "0 милион",
+					"other": "0 милиона",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 miliona",
-					"one":   "00 milion",
-					"other": "00 miliona",
+					"few":   "00 милиона",
+					"one":   "00 милион",
+					"other": "00 милиона",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 miliona",
-					"one":   "000 milion",
-					"other": "000 miliona",
+					"few":   "000 милиона",
+					"one":   "000 милион",
+					"other": "000 милиона",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 milijarde",
-					"one":   "0 milijarda",
-					"other": "0 milijardi",
+					"few":   "0 милијарде",
+					"one":   "0 милијарда",
+					"other": "0 милијарди",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 milijarde",
-					"one":   "00 milijarda",
-					"other": "00 milijardi",
+					"few":   "00 милијарде",
+					"one":   "00 милијарда",
+					"other": "00 милијарди",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 milijarde",
-					"one":   "000 milijarda",
-					"other": "000 milijardi",
+					"few":   "000 милијарде",
+					"one":   "000 милијарда",
+					"other": "000 милијарди",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 biliona",
-					"one":   "0 bilion",
-					"other": "0 biliona",
+					"few":   "0 билиона",
+					"one":   "0 билион",
+					"other": "0 билиона",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 biliona",
-					"one":   "00 bilion",
-					"other": "00 biliona",
+					"few":   "00 билиона",
+					"one":   "00 билион",
+					"other": "00 билиона",
 				},
 			},
 		}, Short: {
@@ -62065,201 +62068,201 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 hilj'.'",
-					"one":   "0 hilj'.'",
-					"other": "0 hilj'.'",
+					"few":   "0 хиљ'.'",
+					"one":   "0 хиљ'.'",
+					"other": "0 хиљ'.'",
 				},
 			},
 			{
 				Type:            10000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 hilj'.'",
-					"one":   "00 hilj'.'",
-					"other": "00 hilj'.'",
+					"few":   "00 хиљ'.'",
+					"one":   "00 хиљ'.'",
+					"other": "00 хиљ'.'",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 hilj'.'",
-					"one":   "000 hilj'.'",
-					"other": "000 hilj'.'",
+					"few":   "000 хиљ'.'",
+					"one":   "000 хиљ'.'",
+					"other": "000 хиљ'.'",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 mil'.'",
-					"one":   "0 mil'.'",
-					"other": "0 mil'.'",
+					"few":   "0 мил'.'",
+					"one":   "0 мил'.'",
+					"other": "0 мил'.'",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 mil'.'",
-					"one":   "00 mil'.'",
-					"other": "00 mil'.'",
+					"few":   "00 мил'.'",
+					"one":   "00 мил'.'",
+					"other": "00 мил'.'",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 mil'.'",
-					"one":   "000 mil'.'",
-					"other": "000 mil'.'",
+					"few":   "000 мил'.'",
+					"one":   "000 мил'.'",
+					"other": "000 мил'.'",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 mlrd'.'",
-					"one":   "0 mlrd'.'",
-					"other": "0 mlrd'.'",
+					"few":   "0 млрд'.'",
+					"one":   "0 млрд'.'",
+					"other": "0 млрд'.'",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 mlrd'.'",
-					"one":   "00 mlrd'.'",
-					"other": "00 mlrd'.'",
+					"few":   "00 млрд'.'",
+					"one":   "00 млрд'.'",
+					"other": "00 млрд'.'",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 mlrd'.'",
-					"one":   "000 mlrd'.'",
-					"other": "000 mlrd'.'",
+					"few":   "000 млрд'.'",
+					"one":   "000 млрд'.'",
+					"other": "000 млрд'.'",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 bil'.'",
-					"one":   "0 bil'.'",
-					"other": "0 bil'.'",
+					"few":   "0 бил'.'",
+					"one":   "0 бил'.'",
+					"other": "0 бил'.'",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 bil'.'",
-					"one":   "00 bil'.'",
-					"other": "00 bil'.'",
+					"few":   "00 бил'.'",
+					"one":   "00 бил'.'",
+					"other": "00 бил'.'",
 				},
 			},
 		},
-	}, "sr-BA": map[CompactType][]models.CompactFormRule{
+	}, "sr-Cyrl-BA": map[CompactType][]models.CompactFormRule{
 		Long: {
 			{
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 hiljade",
-					"one":   "0 hiljada",
-					"other": "0 hiljada",
+					"few":   "0 хиљаде",
+					"one":   "0 хиљада",
+					"other": "0 хиљада",
 				},
 			},
 			{
 				Type:            10000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 hiljade",
-					"one":   "00 hiljada",
-					"other": "00 hiljada",
+					"few":   "00 хиљаде",
+					"one":   "00 хиљада",
+					"other": "00 хиљада",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 hiljade",
-					"one":   "000 hiljada",
-					"other": "000 hiljada",
+					"few":   "000 хиљаде",
+					"one":   "000 хиљада",
+					"other": "000 хиљада",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 miliona",
-					"one":   "0 milion",
-					"other": "0 miliona",
+					"few":   "0 милиона",
+					"one":   "0 милион",
+					"other": "0 милиона",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 miliona",
-					"one":   "00 milion",
-					"other": "00 miliona",
+					"few":   "00 милиона",
+					"one":   "00 милион",
+					"other": "00 милиона",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 miliona",
-					"one":   "000 milion",
-					"other": "000 miliona",
+					"few":   "000 милиона",
+					"one":   "000 милион",
+					"other": "000 милиона",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 milijarde",
-					"one":   "0 milijarda",
-					"other": "0 milijardi",
+					"few":   "0 милијарде",
+					"one":   "0 милијарда",
+					"other": "0 милијарди",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 milijarde",
-					"one":   "00 milijarda",
-					"other": "00 milijardi",
+					"few":   "00 милијарде",
+					"one":   "00 милијарда",
+					"other": "00 милијарди",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 milijarde",
-					"one":   "000 milijarda",
-					"other": "000 milijardi",
+					"few":   "000 милијарде",
+					"one":   "000 милијарда",
+					"other": "000 милијарди",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 biliona",
-					"one":   "0 bilion",
-					"other": "0 biliona",
+					"few":   "0 билиона",
+					"one":   "0 билион",
+					"other": "0 билиона",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 biliona",
-					"one":   "00 bilion",
-					"other": "00 biliona",
+					"few":   "00 билиона",
+					"one":   "00 билион",
+					"other": "00 билиона",
 				},
 			},
 		}, Short: {
@@ -62267,201 +62270,201 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 hilj'.'",
-					"one":   "0 hilj'.'",
-					"other": "0 hilj'.'",
+					"few":   "0 хиљ'.'",
+					"one":   "0 хиљ'.'",
+					"other": "0 хиљ'.'",
 				},
 			},
 			{
 				Type:            10000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 hilj'.'",
-					"one":   "00 hilj'.'",
-					"other": "00 hilj'.'",
+					"few":   "00 хиљ'.'",
+					"one":   "00 хиљ'.'",
+					"other": "00 хиљ'.'",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 hilj'.'",
-					"one":   "000 hilj'.'",
-					"other": "000 hilj'.'",
+					"few":   "000 хиљ'.'",
+					"one":   "000 хиљ'.'",
+					"other": "000 хиљ'.'",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 mil'.'",
-					"one":   "0 mil'.'",
-					"other": "0 mil'.'",
+					"few":   "0 мил'.'",
+					"one":   "0 мил'.'",
+					"other": "0 мил'.'",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 mil'.'",
-					"one":   "00 mil'.'",
-					"other": "00 mil'.'",
+					"few":   "00 мил'.'",
+					"one":   "00 мил'.'",
+					"other": "00 мил'.'",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 mil'.'",
-					"one":   "000 mil'.'",
-					"other": "000 mil'.'",
+					"few":   "000 мил'.'",
+					"one":   "000 мил'.'",
+					"other": "000 мил'.'",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 mlrd'.'",
-					"one":   "0 mlrd'.'",
-					"other": "0 mlrd'.'",
+					"few":   "0 млрд'.'",
+					"one":   "0 млрд'.'",
+					"other": "0 млрд'.'",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 mlrd'.'",
-					"one":   "00 mlrd'.'",
-					"other": "00 mlrd'.'",
+					"few":   "00 млрд'.'",
+					"one":   "00 млрд'.'",
+					"other": "00 млрд'.'",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 mlrd'.'",
-					"one":   "000 mlrd'.'",
-					"other": "000 mlrd'.'",
+					"few":   "000 млрд'.'",
+					"one":   "000 млрд'.'",
+					"other": "000 млрд'.'",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 bil'.'",
-					"one":   "0 bil'.'",
-					"other": "0 bil'.'",
+					"few":   "0 бил'.'",
+					"one":   "0 бил'.'",
+					"other": "0 бил'.'",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 bil'.'",
-					"one":   "00 bil'.'",
-					"other": "00 bil'.'",
+					"few":   "00 бил'.'",
+					"one":   "00 бил'.'",
+					"other": "00 бил'.'",
 				},
 			},
 		},
-	}, "sr-ME": map[CompactType][]models.CompactFormRule{
+	}, "sr-Cyrl-XK": map[CompactType][]models.CompactFormRule{
 		Long: {
 			{
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 hiljade",
-					"one":   "0 hiljada",
-					"other": "0 hiljada",
+					"few":   "0 хиљаде",
+					"one":   "0 хиљада",
+					"other": "0 хиљада",
 				},
 			},
 			{
 				Type:            10000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 hiljade",
-					"one":   "00 hiljada",
-					"other": "00 hiljada",
+					"few":   "00 хиљаде",
+					"one":   "00 хиљада",
+					"other": "00 хиљада",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 hiljade",
-					"one":   "000 hiljada",
-					"other": "000 hiljada",
+					"few":   "000 хиљаде",
+					"one":   "000 хиљада",
+					"other": "000 хиљада",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 miliona",
-					"one":   "0 milion",
-					"other": "0 miliona",
+					"few":   "0 милиона",
+					"one":   "0 милион",
+					"other": "0 милиона",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 miliona",
-					"one":   "00 milion",
-					"other": "00 miliona",
+					"few":   "00 милиона",
+					"one":   "00 милион",
+					"other": "00 милиона",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 miliona",
-					"one":   "000 milion",
-					"other": "000 miliona",
+					"few":   "000 милиона",
+					"one":   "000 милион",
+					"other": "000 милиона",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 milijarde",
-					"one":   "0 milijarda",
-					"other": "0 milijardi",
+					"few":   "0 милијарде",
+					"one":   "0 милијарда",
+					"other": "0 милијарди",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 milijarde",
-					"one":   "00 milijarda",
-					"other": "00 milijardi",
+					"few":   "00 милијарде",
+					"one":   "00 милијарда",
+					"other": "00 милијарди",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 milijarde",
-					"one":   "000 milijarda",
-					"other": "000 milijardi",
+					"few":   "000 милијарде",
+					"one":   "000 милијарда",
+					"other": "000 милијарди",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 biliona",
-					"one":   "0 bilion",
-					"other": "0 biliona",
+					"few":   "0 билиона",
+					"one":   "0 билион",
+					"other": "0 билиона",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 biliona",
-					"one":   "00 bilion",
-					"other": "00 biliona",
+					"few":   "00 билиона",
+					"one":   "00 билион",
+					"other": "00 билиона",
 				},
 			},
 		}, Short: {
@@ -62469,103 +62472,709 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 hilj'.'",
-					"one":   "0 hilj'.'",
-					"other": "0 hilj'.'",
+					"few":   "0 хиљ'.'",
+					"one":   "0 хиљ'.'",
+					"other": "0 хиљ'.'",
 				},
 			},
 			{
 				Type:            10000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 hilj'.'",
-					"one":   "00 hilj'.'",
-					"other": "00 hilj'.'",
+					"few":   "00 хиљ'.'",
+					"one":   "00 хиљ'.'",
+					"other": "00 хиљ'.'",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 hilj'.'",
-					"one":   "000 hilj'.'",
-					"other": "000 hilj'.'",
+					"few":   "000 хиљ'.'",
+					"one":   "000 хиљ'.'",
+					"other": "000 хиљ'.'",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 mil'.'",
-					"one":   "0 mil'.'",
-					"other": "0 mil'.'",
+					"few":   "0 мил'.'",
+					"one":   "0 мил'.'",
+					"other": "0 мил'.'",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 mil'.'",
-					"one":   "00 mil'.'",
-					"other": "00 mil'.'",
+					"few":   "00 мил'.'",
+					"one":   "00 мил'.'",
+					"other": "00 мил'.'",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 mil'.'",
-					"one":   "000 mil'.'",
-					"other": "000 mil'.'",
+					"few":   "000 мил'.'",
+					"one":   "000 мил'.'",
+					"other": "000 мил'.'",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 mlrd'.'",
-					"one":   "0 mlrd'.'",
-					"other": "0 mlrd'.'",
+					"few":   "0 млрд'.'",
+					"one":   "0 млрд'.'",
+					"other": "0 млрд'.'",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 mlrd'.'",
-					"one":   "00 mlrd'.'",
-					"other": "00 mlrd'.'",
+					"few":   "00 млрд'.'",
+					"one":   "00 млрд'.'",
+					"other": "00 млрд'.'",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"few":   "000 mlrd'.'",
-					"one":   "000 mlrd'.'",
-					"other": "000 mlrd'.'",
+					"few":   "000 млрд'.'",
+					"one":   "000 млрд'.'",
+					"other": "000 млрд'.'",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"few":   "0 bil'.'",
-					"one":   "0 bil'.'",
-					"other": "0 bil'.'",
+					"few":   "0 бил'.'",
+					"one":   "0 бил'.'",
+					"other": "0 бил'.'",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"few":   "00 bil'.'",
-					"one":   "00 bil'.'",
-					"other": "00 bil'.'",
+					"few":   "00 бил'.'",
+					"one":   "00 бил'.'",
+					"other": "00 бил'.'",
 				},
 			},
 		},
-	}, "sr-XK": map[CompactType][]models.CompactFormRule{
+	}, "sr-Latn": map[CompactType][]models.CompactFormRule{
+		Long: {
+			{
+				Type:            1000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 hiljade",
+					"one":   "0 hiljada",
+					"other": "0 hiljada",
+				},
+			},
+			{
+				Type:            10000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 hiljade",
+					"one":   "00 hiljada",
+					"other": "00 hiljada",
+				},
+			},
+			{
+				Type:            100000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 hiljade",
+					"one":   "000 hiljada",
+					"other": "000 hiljada",
+				},
+			},
+			{
+				Type:            1000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 miliona",
+					"one":   "0 milion",
+					"other": "0 miliona",
+				},
+			},
+			{
+				Type:            10000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 miliona",
+					"one":   "00 milion",
+					"other": "00 miliona",
+				},
+			},
+			{
+				Type:            100000000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 miliona",
+					"one":   "000 milion",
+					"other": "000 miliona",
+				},
+			},
+			{
+				Type:            1000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 milijarde",
+					"one":   "0 milijarda",
+					"other": "0 milijardi",
+				},
+			},
+			{
+				Type:            10000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 milijarde",
+					"one":   "00 milijarda",
+					"other": "00 milijardi",
+				},
+			},
+			{
+				Type:            100000000000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 milijarde",
+					"one":   "000 milijarda",
+					"other": "000 milijardi",
+				},
+			},
+			{
+				Type:            1000000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 biliona",
+					"one":   "0 bilion",
+					"other": "0 biliona",
+				},
+			},
+			{
+				Type:            10000000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 biliona",
+					"one":   "00 bilion",
+					"other": "00 biliona",
+				},
+			},
+		}, Short: {
+			{
+				Type:            1000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 hilj'.'",
+					"one":   "0 hilj'.'",
+					"other": "0 hilj'.'",
+				},
+			},
+			{
+				Type:            10000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 hilj'.'",
+					"one":   "00 hilj'.'",
+					"other": "00 hilj'.'",
+				},
+			},
+			{
+				Type:            100000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 hilj'.'",
+					"one":   "000 hilj'.'",
+					"other": "000 hilj'.'",
+				},
+			},
+			{
+				Type:            1000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 mil'.'",
+					"one":   "0 mil'.'",
+					"other": "0 mil'.'",
+				},
+			},
+			{
+				Type:            10000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 mil'.'",
+					"one":   "00 mil'.'",
+					"other": "00 mil'.'",
+				},
+			},
+			{
+				Type:            100000000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 mil'.'",
+					"one":   "000 mil'.'",
+					"other": "000 mil'.'",
+				},
+			},
+			{
+				Type:            1000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 mlrd'.'",
+					"one":   "0 mlrd'.'",
+					"other": "0 mlrd'.'",
+				},
+			},
+			{
+				Type:            10000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 mlrd'.'",
+					"one":   "00 mlrd'.'",
+					"other": "00 mlrd'.'",
+				},
+			},
+			{
+				Type:            100000000000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 mlrd'.'",
+					"one":   "000 mlrd'.'",
+					"other": "000 mlrd'.'",
+				},
+			},
+			{
+				Type:            1000000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 bil'.'",
+					"one":   "0 bil'.'",
+					"other": "0 bil'.'",
+				},
+			},
+			{
+				Type:            10000000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 bil'.'",
+					"one":   "00 bil'.'",
+					"other": "00 bil'.'",
+				},
+			},
+		},
+	}, "sr-Latn-BA": map[CompactType][]models.CompactFormRule{
+		Long: {
+			{
+				Type:            1000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 hiljade",
+					"one":   "0 hiljada",
+					"other": "0 hiljada",
+				},
+			},
+			{
+				Type:            10000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 hiljade",
+					"one":   "00 hiljada",
+					"other": "00 hiljada",
+				},
+			},
+			{
+				Type:            100000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 hiljade",
+					"one":   "000 hiljada",
+					"other": "000 hiljada",
+				},
+			},
+			{
+				Type:            1000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 miliona",
+					"one":   "0 milion",
+					"other": "0 miliona",
+				},
+			},
+			{
+				Type:            10000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 miliona",
+					"one":   "00 milion",
+					"other": "00 miliona",
+				},
+			},
+			{
+				Type:            100000000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 miliona",
+					"one":   "000 milion",
+					"other": "000 miliona",
+				},
+			},
+			{
+				Type:            1000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 milijarde",
+					"one":   "0 milijarda",
+					"other": "0 milijardi",
+				},
+			},
+			{
+				Type:            10000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 milijarde",
+					"one":   "00 milijarda",
+					"other": "00 milijardi",
+				},
+			},
+			{
+				Type:            100000000000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 milijarde",
+					"one":   "000 milijarda",
+					"other": "000 milijardi",
+				},
+			},
+			{
+				Type:            1000000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 biliona",
+					"one":   "0 bilion",
+					"other": "0 biliona",
+				},
+			},
+			{
+				Type:            10000000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 biliona",
+					"one":   "00 bilion",
+					"other": "00 biliona",
+				},
+			},
+		}, Short: {
+			{
+				Type:            1000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 hilj'.'",
+					"one":   "0 hilj'.'",
+					"other": "0 hilj'.'",
+				},
+			},
+			{
+				Type:            10000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 hilj'.'",
+					"one":   "00 hilj'.'",
+					"other": "00 hilj'.'",
+				},
+			},
+			{
+				Type:            100000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 hilj'.'",
+					"one":   "000 hilj'.'",
+					"other": "000 hilj'.'",
+				},
+			},
+			{
+				Type:            1000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 mil'.'",
+					"one":   "0 mil'.'",
+					"other": "0 mil'.'",
+				},
+			},
+			{
+				Type:            10000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 mil'.'",
+					"one":   "00 mil'.'",
+					"other": "00 mil'.'",
+				},
+			},
+			{
+				Type:            100000000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 mil'.'",
+					"one":   "000 mil'.'",
+					"other": "000 mil'.'",
+				},
+			},
+			{
+				Type:            1000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 mlrd'.'",
+					"one":   "0 mlrd'.'",
+					"other": "0 mlrd'.'",
+				},
+			},
+			{
+				Type:            10000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 mlrd'.'",
+					"one":   "00 mlrd'.'",
+					"other": "00 mlrd'.'",
+				},
+			},
+			{
+				Type:            100000000000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 mlrd'.'",
+					"one":   "000 mlrd'.'",
+					"other": "000 mlrd'.'",
+				},
+			},
+			{
+				Type:            1000000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 bil'.'",
+					"one":   "0 bil'.'",
+					"other": "0 bil'.'",
+				},
+			},
+			{
+				Type:            10000000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 bil'.'",
+					"one":   "00 bil'.'",
+					"other": "00 bil'.'",
+				},
+			},
+		},
+	}, "sr-Latn-ME": map[CompactType][]models.CompactFormRule{
+		Long: {
+			{
+				Type:            1000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 hiljade",
+					"one":   "0 hiljada",
+					"other": "0 hiljada",
+				},
+			},
+			{
+				Type:            10000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 hiljade",
+					"one":   "00 hiljada",
+					"other": "00 hiljada",
+				},
+			},
+			{
+				Type:            100000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 hiljade",
+					"one":   "000 hiljada",
+					"other": "000 hiljada",
+				},
+			},
+			{
+				Type:            1000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 miliona",
+					"one":   "0 milion",
+					"other": "0 miliona",
+				},
+			},
+			{
+				Type:            10000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 miliona",
+					"one":   "00 milion",
+					"other": "00 miliona",
+				},
+			},
+			{
+				Type:            100000000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 miliona",
+					"one":   "000 milion",
+					"other": "000 miliona",
+				},
+			},
+			{
+				Type:            1000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 milijarde",
+					"one":   "0 milijarda",
+					"other": "0 milijardi",
+				},
+			},
+			{
+				Type:            10000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 milijarde",
+					"one":   "00 milijarda",
+					"other": "00 milijardi",
+				},
+			},
+			{
+				Type:            100000000000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 milijarde",
+					"one":   "000 milijarda",
+					"other": "000 milijardi",
+				},
+			},
+			{
+				Type:            1000000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 biliona",
+					"one":   "0 bilion",
+					"other": "0 biliona",
+				},
+			},
+			{
+				Type:            10000000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 biliona",
+					"one":   "00 bilion",
+					"other": "00 biliona",
+				},
+			},
+		}, Short: {
+			{
+				Type:            1000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 hilj'.'",
+					"one":   "0 hilj'.'",
+					"other": "0 hilj'.'",
+				},
+			},
+			{
+				Type:            10000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 hilj'.'",
+					"one":   "00 hilj'.'",
+					"other": "00 hilj'.'",
+				},
+			},
+			{
+				Type:            100000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 hilj'.'",
+					"one":   "000 hilj'.'",
+					"other": "000 hilj'.'",
+				},
+			},
+			{
+				Type:            1000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 mil'.'",
+					"one":   "0 mil'.'",
+					"other": "0 mil'.'",
+				},
+			},
+			{
+				Type:            10000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 mil'.'",
+					"one":   "00 mil'.'",
+					"other": "00 mil'.'",
+				},
+			},
+			{
+				Type:            100000000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 mil'.'",
+					"one":   "000 mil'.'",
+					"other": "000 mil'.'",
+				},
+			},
+			{
+				Type:            1000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 mlrd'.'",
+					"one":   "0 mlrd'.'",
+					"other": "0 mlrd'.'",
+				},
+			},
+			{
+				Type:            10000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 mlrd'.'",
+					"one":   "00 mlrd'.'",
+					"other": "00 mlrd'.'",
+				},
+			},
+			{
+				Type:            100000000000,
+				ZeroesInPattern: 3,
+				PatternsByPluralForm: map[string]string{
+					"few":   "000 mlrd'.'",
+					"one":   "000 mlrd'.'",
+					"other": "000 mlrd'.'",
+				},
+			},
+			{
+				Type:            1000000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"few":   "0 bil'.'",
+					"one":   "0 bil'.'",
+					"other": "0 bil'.'",
+				},
+			},
+			{
+				Type:            10000000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"few":   "00 bil'.'",
+					"one":   "00 bil'.'",
+					"other": "00 bil'.'",
+				},
+			},
+		},
+	}, "sr-Latn-XK": map[CompactType][]models.CompactFormRule{
 		Long: {
 			{
 				Type:            1000,
@@ -63311,7 +63920,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 		Long: {
 			{
 				Type:            1000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 0;elfu -0",
 					"other": "elfu 0;elfu -0",
@@ -63319,7 +63928,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 00;elfu -00",
 					"other": "elfu 00;elfu -00",
@@ -63327,7 +63936,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 000;elfu -000",
 					"other": "elfu 000;elfu -000",
@@ -63335,7 +63944,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "milioni 0;milioni -0",
 					"other": "milioni 0;milioni -0",
@@ -63343,7 +63952,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "milioni 00;milioni -00",
 					"other": "milioni 00;milioni -00",
@@ -63351,7 +63960,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "milioni 000;milioni -000",
 					"other": "milioni 000;milioni -000",
@@ -63359,7 +63968,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "bilioni 0;bilioni -0",
 					"other": "bilioni 0;bilioni -0",
@@ -63367,7 +63976,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "bilioni 00;bilioni -00",
 					"other": "bilioni 00;bilioni -00",
@@ -63375,7 +63984,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000000000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "bilioni 000;bilioni -000",
 					"other": "bilioni 000;bilioni -000",
@@ -63383,7 +63992,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "trilioni 0;trilioni -0",
 					"other": "trilioni 0;trilioni -0",
@@ -63391,7 +64000,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "trilioni 00;trilioni -00",
 					"other": "trilioni 00;trilioni -00",
@@ -63400,7 +64009,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 		}, Short: {
 			{
 				Type:            1000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 0;elfu -0",
 					"other": "elfu 0;elfu -0",
@@ -63408,7 +64017,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 00;elfu -00",
 					"other": "elfu 00;elfu -00",
@@ -63416,7 +64025,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 000;elfu -000",
 					"other": "elfu 000;elfu -000",
@@ -63424,7 +64033,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "0M;-0M",
 					"other": "0M",
@@ -63432,7 +64041,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "00M;-00M",
 					"other": "00M",
@@ -63440,7 +64049,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "000M;-000M",
 					"other": "000M",
@@ -63448,7 +64057,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "0B;-0B",
 					"other": "0B;-0B",
@@ -63456,7 +64065,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "00B;-00B",
 					"other": "00B;-00B",
@@ -63464,7 +64073,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000000000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "000B;-000B",
 					"other": "000B;-000B",
@@ -63472,7 +64081,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "0T;-0T",
 					"other": "0T",
@@ -63480,7 +64089,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "00T;-00T",
 					"other": "00T",
@@ -63491,7 +64100,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 		Long: {
 			{
 				Type:            1000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 0;elfu -0",
 					"other": "elfu 0;elfu -0",
@@ -63499,7 +64108,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 00;elfu -00",
 					"other": "elfu 00;elfu -00",
@@ -63507,7 +64116,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 000;elfu -000",
 					"other": "elfu 000;elfu -000",
@@ -63515,7 +64124,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "milioni 0;milioni -0",
 					"other": "milioni 0;milioni -0",
@@ -63523,7 +64132,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "milioni 00;milioni -00",
 					"other": "milioni 00;milioni -00",
@@ -63531,7 +64140,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "milioni 000;milioni -000",
 					"other": "milioni 000;milioni -000",
@@ -63539,7 +64148,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "bilioni 0;bilioni -0",
 					"other": "bilioni 0;bilioni -0",
@@ -63547,7 +64156,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "bilioni 00;bilioni -00",
 					"other": "bilioni 00;bilioni -00",
@@ -63555,7 +64164,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000000000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "bilioni 000;bilioni -000",
 					"other": "bilioni 000;bilioni -000",
@@ -63563,7 +64172,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "trilioni 0;trilioni -0",
 					"other": "trilioni 0;trilioni -0",
@@ -63571,7 +64180,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "trilioni 00;trilioni -00",
 					"other": "trilioni 00;trilioni -00",
@@ -63580,7 +64189,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 		}, Short: {
 			{
 				Type:            1000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 0;elfu -0",
 					"other": "elfu 0;elfu -0",
@@ -63588,7 +64197,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 00;elfu -00",
 					"other": "elfu 00;elfu -00",
@@ -63596,7 +64205,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 000;elfu -000",
 					"other": "elfu 000;elfu -000",
@@ -63604,7 +64213,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "0M;-0M",
 					"other": "0M",
@@ -63612,7 +64221,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "00M;-00M",
 					"other": "00M",
@@ -63620,7 +64229,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "000M;-000M",
 					"other": "000M",
@@ -63628,7 +64237,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "0B;-0B",
 					"other": "0B;-0B",
@@ -63636,7 +64245,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "00B;-00B",
 					"other": "00B;-00B",
@@ -63644,7 +64253,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000000000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "000B;-000B",
 					"other": "000B;-000B",
@@ -63652,7 +64261,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "0T;-0T",
 					"other": "0T",
@@ -63660,7 +64269,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "00T;-00T",
 					"other": "00T",
@@ -63671,7 +64280,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 		Long: {
 			{
 				Type:            1000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 0;elfu -0",
 					"other": "elfu 0;elfu -0",
@@ -63679,7 +64288,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 00;elfu -00",
 					"other": "elfu 00",
@@ -63851,7 +64460,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 		Long: {
 			{
 				Type:            1000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 0;elfu -0",
 					"other": "elfu 0;elfu -0",
@@ -63859,7 +64468,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 00;elfu -00",
 					"other": "elfu 00;elfu -00",
@@ -63867,7 +64476,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 000;elfu -000",
 					"other": "elfu 000;elfu -000",
@@ -63875,7 +64484,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "milioni 0;milioni -0",
 					"other": "milioni 0;milioni -0",
@@ -63883,7 +64492,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "milioni 00;milioni -00",
 					"other": "milioni 00;milioni -00",
@@ -63891,7 +64500,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "milioni 000;milioni -000",
 					"other": "milioni 000;milioni -000",
@@ -63899,7 +64508,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "bilioni 0;bilioni -0",
 					"other": "bilioni 0;bilioni -0",
@@ -63907,7 +64516,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "bilioni 00;bilioni -00",
 					"other": "bilioni 00;bilioni -00",
@@ -63915,7 +64524,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000000000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "bilioni 000;bilioni -000",
 					"other": "bilioni 000;bilioni -000",
@@ -63923,7 +64532,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "trilioni 0;trilioni -0",
 					"other": "trilioni 0;trilioni -0",
@@ -63931,7 +64540,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "trilioni 00;trilioni -00",
 					"other": "trilioni 00;trilioni -00",
@@ -63940,7 +64549,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 		}, Short: {
 			{
 				Type:            1000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 0;elfu -0",
 					"other": "elfu 0;elfu -0",
@@ -63948,7 +64557,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 00;elfu -00",
 					"other": "elfu 00;elfu -00",
@@ -63956,7 +64565,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "elfu 000;elfu -000",
 					"other": "elfu 000;elfu -000",
@@ -63964,7 +64573,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "0M;-0M",
 					"other": "0M",
@@ -63972,7 +64581,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "00M;-00M",
 					"other": "00M",
@@ -63980,7 +64589,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "000M;-000M",
 					"other": "000M",
@@ -63988,7 +64597,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "0B;-0B",
 					"other": "0B;-0B",
@@ -63996,7 +64605,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "00B;-00B",
 					"other": "00B;-00B",
@@ -64004,7 +64613,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            100000000000,
-				ZeroesInPattern: 6,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
 					"one":   "000B;-000B",
 					"other": "000B;-000B",
@@ -64012,7 +64621,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            1000000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
 					"one":   "0T;-0T",
 					"other": "0T",
@@ -64020,7 +64629,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 			},
 			{
 				Type:            10000000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
 					"one":   "00T;-00T",
 					"other": "00T",
@@ -66029,94 +66638,274 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				},
 			},
 		},
-	}, "ur-IN": map[CompactType][]models.CompactFormRule{
+	}, "ur-IN": map[CompactType][]models.CompactFormRule{
+		Long: {
+			{
+				Type:            1000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"one":   "0 ہزار",
+					"other": "0 ہزار",
+				},
+			},
+			{
+				Type:            10000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"one":   "00 ہزار",
+					"other": "00 ہزار",
+				},
+			},
+			{
+				Type:            100000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"one":   "0 لاکھ",
+					"other": "0 لاکھ",
+				},
+			},
+			{
+				Type:            1000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"one":   "00 لاکھ",
+					"other": "00 لاکھ",
+				},
+			},
+			{
+				Type:            10000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"one":   "0 کروڑ",
+					"other": "0 کروڑ",
+				},
+			},
+			{
+				Type:            100000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"one":   "00 کروڑ",
+					"other": "00 کروڑ",
+				},
+			},
+			{
+				Type:            1000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"one":   "0 ارب",
+					"other": "0 ارب",
+				},
+			},
+			{
+				Type:            10000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"one":   "00 ارب",
+					"other": "00 ارب",
+				},
+			},
+			{
+				Type:            100000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"one":   "0 کھرب",
+					"other": "0 کھرب",
+				},
+			},
+			{
+				Type:            1000000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"one":   "00 کھرب",
+					"other": "00 کھرب",
+				},
+			},
+			{
+				Type:            10000000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"one":   "00 ٹریلین",
+					"other": "00 ٹریلین",
+				},
+			},
+		}, Short: {
+			{
+				Type:            1000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"one":   "0 ہزار",
+					"other": "0 ہزار",
+				},
+			},
+			{
+				Type:            10000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"one":   "00 ہزار",
+					"other": "00 ہزار",
+				},
+			},
+			{
+				Type:            100000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"one":   "0 لاکھ",
+					"other": "0 لاکھ",
+				},
+			},
+			{
+				Type:            1000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"one":   "00 لاکھ",
+					"other": "00 لاکھ",
+				},
+			},
+			{
+				Type:            10000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"one":   "0 کروڑ",
+					"other": "0 کروڑ",
+				},
+			},
+			{
+				Type:            100000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"one":   "00 کروڑ",
+					"other": "00 کروڑ",
+				},
+			},
+			{
+				Type:            1000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"one":   "0 ارب",
+					"other": "0 ارب",
+				},
+			},
+			{
+				Type:            10000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"one":   "00 ارب",
+					"other": "00 ارب",
+				},
+			},
+			{
+				Type:            100000000000,
+				ZeroesInPattern: 1,
+				PatternsByPluralForm: map[string]string{
+					"one":   "0 کھرب",
+					"other": "0 کھرب",
+				},
+			},
+			{
+				Type:            1000000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"one":   "00 کھرب",
+					"other": "00 کھرب",
+				},
+			},
+			{
+				Type:            10000000000000,
+				ZeroesInPattern: 2,
+				PatternsByPluralForm: map[string]string{
+					"one":   "00 ٹریلین",
+					"other": "00 ٹریلین",
+				},
+			},
+		},
+	}, "uz": map[CompactType][]models.CompactFormRule{
 		Long: {
 			{
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 ہزار",
-					"other": "0 ہزار",
+					"one":   "0 ming",
+					"other": "0 ming",
 				},
 			},
 			{
 				Type:            10000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 ہزار",
-					"other": "00 ہزار",
+					"one":   "00 ming",
+					"other": "00 ming",
 				},
 			},
 			{
 				Type:            100000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 لاکھ",
-					"other": "0 لاکھ",
+					"one":   "000 ming",
+					"other": "000 ming",
 				},
 			},
 			{
 				Type:            1000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 لاکھ",
-					"other": "00 لاکھ",
+					"one":   "0 million",
+					"other": "0 million",
 				},
 			},
 			{
 				Type:            10000000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 کروڑ",
-					"other": "0 کروڑ",
+					"one":   "00 million",
+					"other": "00 million",
 				},
 			},
 			{
 				Type:            100000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 کروڑ",
-					"other": "00 کروڑ",
+					"one":   "000 million",
+					"other": "000 million",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 ارب",
-					"other": "0 ارب",
+					"one":   "0 milliard",
+					"other": "0 milliard",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 ارب",
-					"other": "00 ارب",
+					"one":   "00 milliard",
+					"other": "00 milliard",
 				},
 			},
 			{
 				Type:            100000000000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 کھرب",
-					"other": "0 کھرب",
+					"one":   "000 milliard",
+					"other": "000 milliard",
 				},
 			},
 			{
 				Type:            1000000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 کھرب",
-					"other": "00 کھرب",
+					"one":   "0 trillion",
+					"other": "0 trillion",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 ٹریلین",
-					"other": "00 ٹریلین",
+					"one":   "00 trillion",
+					"other": "00 trillion",
 				},
 			},
 		}, Short: {
@@ -66124,179 +66913,168 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 ہزار",
-					"other": "0 ہزار",
+					"one":   "0 ming",
+					"other": "0 ming",
 				},
 			},
 			{
 				Type:            10000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 ہزار",
-					"other": "00 ہزار",
+					"one":   "00 ming",
+					"other": "00 ming",
 				},
 			},
 			{
 				Type:            100000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 لاکھ",
-					"other": "0 لاکھ",
+					"one":   "000 ming",
+					"other": "000 ming",
 				},
 			},
 			{
 				Type:            1000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 لاکھ",
-					"other": "00 لاکھ",
+					"one":   "0 mln",
+					"other": "0 mln",
 				},
 			},
 			{
 				Type:            10000000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 کروڑ",
-					"other": "0 کروڑ",
+					"one":   "00 mln",
+					"other": "00 mln",
 				},
 			},
 			{
 				Type:            100000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 کروڑ",
-					"other": "00 کروڑ",
+					"one":   "000 mln",
+					"other": "000 mln",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 ارب",
-					"other": "0 ارب",
+					"one":   "0 mlrd",
+					"other": "0 mlrd",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 ارب",
-					"other": "00 ارب",
+					"one":   "00 mlrd",
+					"other": "00 mlrd",
 				},
 			},
 			{
 				Type:            100000000000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 کھرب",
-					"other": "0 کھرب",
+					"one":   "000 mlrd",
+					"other": "000 mlrd",
 				},
 			},
 			{
 				Type:            1000000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 کھرب",
-					"other": "00 کھرب",
+					"one":   "0 trln",
+					"other": "0 trln",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 ٹریلین",
-					"other": "00 ٹریلین",
+					"one":   "00 trln",
+					"other": "00 trln",
 				},
 			},
 		},
-	}, "uz": map[CompactType][]models.CompactFormRule{
+	}, "vi": map[CompactType][]models.CompactFormRule{
 		Long: {
 			{
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 ming",
-					"other": "0 ming",
+					"other": "0 nghìn",
 				},
 			},
 			{
 				Type:            10000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 ming",
-					"other": "00 ming",
+					"other": "00 nghìn",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"one":   "000 ming",
-					"other": "000 ming",
+					"other": "000 nghìn",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 million",
-					"other": "0 million",
+					"other": "0 triệu",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 million",
-					"other": "00 million",
+					"other": "00 triệu",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"one":   "000 million",
-					"other": "000 million",
+					"other": "000 triệu",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 milliard",
-					"other": "0 milliard",
+					"other": "0 tỷ",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 milliard",
-					"other": "00 milliard",
+					"other": "00 tỷ",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"one":   "000 milliard",
-					"other": "000 milliard",
+					"other": "000 tỷ",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 trillion",
-					"other": "0 trillion",
+					"other": "0 nghìn tỷ",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 trillion",
-					"other": "00 trillion",
+					"other": "00 nghìn tỷ",
 				},
 			},
 		}, Short: {
@@ -66304,168 +67082,157 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 ming",
-					"other": "0 ming",
+					"other": "0 N",
 				},
 			},
 			{
 				Type:            10000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 ming",
-					"other": "00 ming",
+					"other": "00 N",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"one":   "000 ming",
-					"other": "000 ming",
+					"other": "000 N",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 mln",
-					"other": "0 mln",
+					"other": "0 Tr",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 mln",
-					"other": "00 mln",
+					"other": "00 Tr",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"one":   "000 mln",
-					"other": "000 mln",
+					"other": "000 Tr",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 mlrd",
-					"other": "0 mlrd",
+					"other": "0 T",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 mlrd",
-					"other": "00 mlrd",
+					"other": "00 T",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"one":   "000 mlrd",
-					"other": "000 mlrd",
+					"other": "000 T",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"one":   "0 trln",
-					"other": "0 trln",
+					"other": "0 NT",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"one":   "00 trln",
-					"other": "00 trln",
+					"other": "00 NT",
 				},
 			},
 		},
-	}, "vi": map[CompactType][]models.CompactFormRule{
+	}, "yue": map[CompactType][]models.CompactFormRule{
 		Long: {
 			{
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0 nghìn",
+					"other": "0",
 				},
 			},
 			{
 				Type:            10000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "00 nghìn",
+					"other": "0萬",
 				},
 			},
 			{
 				Type:            100000,
-				ZeroesInPattern: 3,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "000 nghìn",
+					"other": "00萬",
 				},
 			},
 			{
 				Type:            1000000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "0 triệu",
+					"other": "000萬",
 				},
 			},
 			{
 				Type:            10000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "00 triệu",
+					"other": "0000萬",
 				},
 			},
 			{
 				Type:            100000000,
-				ZeroesInPattern: 3,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "000 triệu",
+					"other": "0億",
 				},
 			},
 			{
 				Type:            1000000000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "0 tỷ",
+					"other": "00億",
 				},
 			},
 			{
 				Type:            10000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "00 tỷ",
+					"other": "000億",
 				},
 			},
 			{
 				Type:            100000000000,
-				ZeroesInPattern: 3,
+				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "000 tỷ",
+					"other": "0000億",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0 nghìn tỷ",
+					"other": "0兆",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00 nghìn tỷ",
+					"other": "00兆",
 				},
 			},
 		}, Short: {
@@ -66473,81 +67240,81 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0 N",
+					"other": "0",
 				},
 			},
 			{
 				Type:            10000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "00 N",
+					"other": "0萬",
 				},
 			},
 			{
 				Type:            100000,
-				ZeroesInPattern: 3,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "000 N",
+					"other": "00萬",
 				},
 			},
 			{
 				Type:            1000000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "0 Tr",
+					"other": "000萬",
 				},
 			},
 			{
 				Type:            10000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "00 Tr",
+					"other": "0000萬",
 				},
 			},
 			{
 				Type:            100000000,
-				ZeroesInPattern: 3,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "000 Tr",
+					"other": "0億",
 				},
 			},
 			{
 				Type:            1000000000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "0 T",
+					"other": "00億",
 				},
 			},
 			{
 				Type:            10000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "00 T",
+					"other": "000億",
 				},
 			},
 			{
 				Type:            100000000000,
-				ZeroesInPattern: 3,
+				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "000 T",
+					"other": "0000億",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0 NT",
+					"other": "0兆",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00 NT",
+					"other": "00兆",
 				},
 			},
 		},
-	}, "yue": map[CompactType][]models.CompactFormRule{
+	}, "zh": map[CompactType][]models.CompactFormRule{
 		Long: {
 			{
 				Type:            1000,
@@ -66560,70 +67327,70 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            10000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0萬",
+					"other": "0万",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00萬",
+					"other": "00万",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "000萬",
+					"other": "000万",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "0000萬",
+					"other": "0000万",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0億",
+					"other": "0亿",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00億",
+					"other": "00亿",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "000億",
+					"other": "000亿",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "0000億",
+					"other": "0000亿",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0兆",
+					"other": "0万亿",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00兆",
+					"other": "00万亿",
 				},
 			},
 		}, Short: {
@@ -66638,74 +67405,74 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            10000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0萬",
+					"other": "0万",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00萬",
+					"other": "00万",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "000萬",
+					"other": "000万",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "0000萬",
+					"other": "0000万",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0億",
+					"other": "0亿",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00億",
+					"other": "00亿",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "000億",
+					"other": "000亿",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "0000億",
+					"other": "0000亿",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0兆",
+					"other": "0万亿",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00兆",
+					"other": "00万亿",
 				},
 			},
 		},
-	}, "zh": map[CompactType][]models.CompactFormRule{
+	}, "zh-Hans-SG": map[CompactType][]models.CompactFormRule{
 		Long: {
 			{
 				Type:            1000,
@@ -66718,70 +67485,70 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            10000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0萬",
+					"other": "0万",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00萬",
+					"other": "00万",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "000萬",
+					"other": "000万",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "0000萬",
+					"other": "0000万",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0億",
+					"other": "0亿",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00億",
+					"other": "00亿",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "000億",
+					"other": "000亿",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "0000億",
+					"other": "0000亿",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0兆",
+					"other": "0万亿",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00兆",
+					"other": "00万亿",
 				},
 			},
 		}, Short: {
@@ -66796,74 +67563,74 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            10000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0萬",
+					"other": "0万",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00萬",
+					"other": "00万",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "000萬",
+					"other": "000万",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "0000萬",
+					"other": "0000万",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0億",
+					"other": "0亿",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00億",
+					"other": "00亿",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "000億",
+					"other": "000亿",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "0000億",
+					"other": "0000亿",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0兆",
+					"other": "0万亿",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00兆",
+					"other": "00万亿",
 				},
 			},
 		},
-	}, "zh-HK": map[CompactType][]models.CompactFormRule{
+	}, "zh-Hant": map[CompactType][]models.CompactFormRule{
 		Long: {
 			{
 				Type:            1000,
@@ -66947,81 +67714,81 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0K",
+					"other": "0",
 				},
 			},
 			{
 				Type:            10000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "00K",
+					"other": "0萬",
 				},
 			},
 			{
 				Type:            100000,
-				ZeroesInPattern: 3,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "000K",
+					"other": "00萬",
 				},
 			},
 			{
 				Type:            1000000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "0M",
+					"other": "000萬",
 				},
 			},
 			{
 				Type:            10000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "00M",
+					"other": "0000萬",
 				},
 			},
 			{
 				Type:            100000000,
-				ZeroesInPattern: 3,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "000M",
+					"other": "0億",
 				},
 			},
 			{
 				Type:            1000000000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "0B",
+					"other": "00億",
 				},
 			},
 			{
 				Type:            10000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "00B",
+					"other": "000億",
 				},
 			},
 			{
 				Type:            100000000000,
-				ZeroesInPattern: 3,
+				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "000B",
+					"other": "0000億",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0T",
+					"other": "0兆",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00T",
+					"other": "00兆",
 				},
 			},
 		},
-	}, "zh-MO": map[CompactType][]models.CompactFormRule{
+	}, "zh-Hant-HK": map[CompactType][]models.CompactFormRule{
 		Long: {
 			{
 				Type:            1000,
@@ -67179,7 +67946,7 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				},
 			},
 		},
-	}, "zh-SG": map[CompactType][]models.CompactFormRule{
+	}, "zh-Hant-MO": map[CompactType][]models.CompactFormRule{
 		Long: {
 			{
 				Type:            1000,
@@ -67192,70 +67959,70 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            10000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0万",
+					"other": "0萬",
 				},
 			},
 			{
 				Type:            100000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00万",
+					"other": "00萬",
 				},
 			},
 			{
 				Type:            1000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "000万",
+					"other": "000萬",
 				},
 			},
 			{
 				Type:            10000000,
 				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "0000万",
+					"other": "0000萬",
 				},
 			},
 			{
 				Type:            100000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0亿",
+					"other": "0億",
 				},
 			},
 			{
 				Type:            1000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00亿",
+					"other": "00億",
 				},
 			},
 			{
 				Type:            10000000000,
 				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "000亿",
+					"other": "000億",
 				},
 			},
 			{
 				Type:            100000000000,
 				ZeroesInPattern: 4,
 				PatternsByPluralForm: map[string]string{
-					"other": "0000亿",
+					"other": "0000億",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0万亿",
+					"other": "0兆",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00万亿",
+					"other": "00兆",
 				},
 			},
 		}, Short: {
@@ -67263,77 +68030,77 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 				Type:            1000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0",
+					"other": "0K",
 				},
 			},
 			{
 				Type:            10000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "0万",
+					"other": "00K",
 				},
 			},
 			{
 				Type:            100000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "00万",
+					"other": "000K",
 				},
 			},
 			{
 				Type:            1000000,
-				ZeroesInPattern: 3,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "000万",
+					"other": "0M",
 				},
 			},
 			{
 				Type:            10000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "0000万",
+					"other": "00M",
 				},
 			},
 			{
 				Type:            100000000,
-				ZeroesInPattern: 1,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "0亿",
+					"other": "000M",
 				},
 			},
 			{
 				Type:            1000000000,
-				ZeroesInPattern: 2,
+				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "00亿",
+					"other": "0B",
 				},
 			},
 			{
 				Type:            10000000000,
-				ZeroesInPattern: 3,
+				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "000亿",
+					"other": "00B",
 				},
 			},
 			{
 				Type:            100000000000,
-				ZeroesInPattern: 4,
+				ZeroesInPattern: 3,
 				PatternsByPluralForm: map[string]string{
-					"other": "0000亿",
+					"other": "000B",
 				},
 			},
 			{
 				Type:            1000000000000,
 				ZeroesInPattern: 1,
 				PatternsByPluralForm: map[string]string{
-					"other": "0万亿",
+					"other": "0T",
 				},
 			},
 			{
 				Type:            10000000000000,
 				ZeroesInPattern: 2,
 				PatternsByPluralForm: map[string]string{
-					"other": "00万亿",
+					"other": "00T",
 				},
 			},
 		},
@@ -67519,3 +68286,3079 @@ var compactFormsByLanguage = map[string]map[CompactType][]models.CompactFormRule
 		},
 	},
 }
+
+// miscPatternsByLanguage holds the patterns of ranges, approximate numbers and bounds of every locale.
+var miscPatternsByLanguage = map[string]models.MiscPatterns{
+	"af": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"af-NA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"am": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-AE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-BH": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-DJ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-DZ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-EG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-EH": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-ER": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-IL": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-IQ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-JO": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-KM": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-KW": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-LB": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-LY": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-MA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-MR": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-OM": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-PS": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-QA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-SA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-SD": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-SO": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-SS": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-SY": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-TD": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-TN": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ar-YE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"as": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"az": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"be": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"bg": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"bn": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"bn-IN": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"bs": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ca": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"ca-AD": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"ca-ES": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"ca-FR": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"ca-IT": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"cs": {
+		Approximately: "~{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"cy": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"da": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"da-GL": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"de": {
+		Approximately: "≈{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"de-AT": {
+		Approximately: "≈{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"de-BE": {
+		Approximately: "≈{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"de-CH": {
+		Approximately: "≈{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"de-IT": {
+		Approximately: "≈{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"de-LI": {
+		Approximately: "≈{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"de-LU": {
+		Approximately: "≈{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"el": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"el-CY": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"en": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-001": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-150": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-AE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-AG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-AI": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-AS": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-AT": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-AU": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-BB": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-BE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-BI": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-BM": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-BS": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-BW": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-BZ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-CA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-CC": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-CH": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-CK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-CM": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-CX": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-CY": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-DE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-DG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-DK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-DM": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-ER": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-FI": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-FJ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-FK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-FM": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-GB": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-GD": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-GG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-GH": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-GI": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-GM": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-GU": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-GY": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-HK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-IE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-IL": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-IM": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-IN": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-IO": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-JE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-JM": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-KE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-KI": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-KN": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-KY": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-LC": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-LR": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-LS": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-MG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-MH": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-MO": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-MP": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-MS": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-MT": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-MU": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-MW": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-MY": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-NA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-NF": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-NG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-NL": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-NR": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-NU": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-NZ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-PG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-PH": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-PK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-PN": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-PR": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-PW": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-RW": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-SB": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-SC": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-SD": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-SE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-SG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-SH": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-SI": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-SL": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-SS": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-SX": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-SZ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-TC": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-TK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-TO": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-TT": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-TV": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-TZ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-UG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-UM": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-US": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-VC": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-VG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-VI": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-VU": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-WS": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-ZA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-ZM": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"en-ZW": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"es": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-419": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-AR": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-BO": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-BR": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-BZ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-CL": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-CO": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-CR": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-CU": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-DO": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-EA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-EC": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-GQ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-GT": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-HN": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-IC": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-MX": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-NI": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-PA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-PE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-PH": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-PR": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-PY": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-SV": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-US": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-UY": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"es-VE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"et": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"eu": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fa": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fa-AF": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fi": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fil": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-BE": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-BF": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-BI": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-BJ": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-BL": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-CA": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-CD": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-CF": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-CG": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-CH": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-CI": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-CM": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-DJ": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-DZ": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-GA": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-GF": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-GN": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-GP": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-GQ": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-HT": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-KM": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-LU": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-MA": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-MC": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-MF": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-MG": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-ML": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-MQ": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-MR": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-MU": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-NC": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-NE": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-PF": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-PM": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-RE": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-RW": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-SC": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-SN": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-SY": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-TD": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-TG": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-TN": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-VU": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-WF": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"fr-YT": {
+		Approximately: "≃{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ga": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ga-GB": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"gl": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"gu": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"he": {
+		Approximately: "‎~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"hi": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"hr": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"hr-BA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"hu": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"hy": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"id": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"is": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"it": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"it-CH": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"it-SM": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"it-VA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"ja": {
+		Approximately: "約{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}～{1}",
+	},
+	"jv": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ka": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"kk": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"km": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"kn": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ko": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}~{1}",
+	},
+	"ko-KP": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}~{1}",
+	},
+	"ky": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"lo": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"lt": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"lv": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"mk": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ml": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"mn": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"mr": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ms": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ms-BN": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ms-SG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"my": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"nb": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"nb-SJ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ne": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ne-IN": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"nl": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"nl-AW": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"nl-BE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"nl-BQ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"nl-CW": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"nl-SR": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"nl-SX": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"no": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"or": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pa": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pl": {
+		Approximately: "~{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ps": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ps-PK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pt": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pt-AO": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pt-CH": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pt-CV": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pt-GQ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pt-GW": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pt-LU": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pt-MO": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pt-MZ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pt-PT": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pt-ST": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"pt-TL": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ro": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"ro-MD": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"root": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ru": {
+		Approximately: "≈{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ru-BY": {
+		Approximately: "≈{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ru-KG": {
+		Approximately: "≈{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ru-KZ": {
+		Approximately: "≈{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ru-MD": {
+		Approximately: "≈{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ru-UA": {
+		Approximately: "≈{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sd": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"si": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sk": {
+		Approximately: "~{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sl": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"so": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"so-DJ": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"so-ET": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"so-KE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sq": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sq-MK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sq-XK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sr": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sr-Cyrl-BA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sr-Cyrl-XK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sr-Latn": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sr-Latn-BA": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sr-Latn-ME": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sr-Latn-XK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sv": {
+		Approximately: "~{0}",
+		AtLeast:       "⩾{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}‒{1}",
+	},
+	"sv-AX": {
+		Approximately: "~{0}",
+		AtLeast:       "⩾{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}‒{1}",
+	},
+	"sv-FI": {
+		Approximately: "~{0}",
+		AtLeast:       "⩾{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}‒{1}",
+	},
+	"sw": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sw-CD": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sw-KE": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"sw-UG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ta": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ta-LK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ta-MY": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ta-SG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"te": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"th": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"tk": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"tr": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"tr-CY": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"uk": {
+		Approximately: "≈{0}",
+		AtLeast:       "≥{0}",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ur": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"ur-IN": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"uz": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+	"vi": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"yue": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"zh": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"zh-Hans-SG": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"zh-Hant": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"zh-Hant-HK": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"zh-Hant-MO": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}-{1}",
+	},
+	"zu": {
+		Approximately: "~{0}",
+		AtLeast:       "{0}+",
+		AtMost:        "≤{0}",
+		Range:         "{0}–{1}",
+	},
+}
+
+// signsByLanguage holds the plus sign and accounting format of every locale.
+var signsByLanguage = map[string]models.Signs{
+	"af":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"af-NA":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"am":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar":         {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-AE":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-BH":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-DJ":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-DZ":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-EG":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-EH":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-ER":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-IL":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-IQ":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-JO":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-KM":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-KW":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-LB":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-LY":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-MA":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-MR":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-OM":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-PS":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-QA":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-SA":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-SD":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-SO":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-SS":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-SY":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-TD":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-TN":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ar-YE":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"as":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"az":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"be":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"bg":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"bn":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"bn-IN":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"bs":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ca":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ca-AD":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ca-ES":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ca-FR":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ca-IT":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"cs":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"cy":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"da":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"da-GL":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"de":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"de-AT":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"de-BE":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"de-CH":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"de-IT":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"de-LI":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"de-LU":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"el":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"el-CY":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"en":         {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-001":     {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-150":     {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-AE":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-AG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-AI":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-AS":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-AT":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-AU":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-BB":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-BE":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-BI":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-BM":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-BS":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-BW":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-BZ":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-CA":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-CC":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-CH":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-CK":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-CM":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-CX":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-CY":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-DE":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-DG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-DK":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-DM":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-ER":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-FI":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-FJ":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-FK":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-FM":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-GB":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-GD":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-GG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-GH":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-GI":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-GM":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-GU":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-GY":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-HK":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-IE":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-IL":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-IM":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-IN":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-IO":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-JE":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-JM":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-KE":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-KI":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-KN":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-KY":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-LC":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-LR":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-LS":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-MG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-MH":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-MO":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-MP":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-MS":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-MT":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-MU":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-MW":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-MY":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-NA":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-NF":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-NG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-NL":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-NR":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-NU":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-NZ":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-PG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-PH":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-PK":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-PN":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-PR":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-PW":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-RW":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-SB":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-SC":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-SD":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-SE":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-SG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-SH":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-SI":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-SL":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-SS":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-SX":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-SZ":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-TC":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-TK":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-TO":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-TT":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-TV":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-TZ":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-UG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-UM":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-US":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-VC":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-VG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-VI":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-VU":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-WS":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-ZA":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-ZM":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"en-ZW":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"es":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-419":     {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-AR":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-BO":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-BR":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-BZ":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-CL":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-CO":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-CR":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-CU":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-DO":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-EA":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-EC":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-GQ":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-GT":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-HN":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-IC":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-MX":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-NI":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-PA":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-PE":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-PH":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-PR":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-PY":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-SV":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-US":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-UY":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"es-VE":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"et":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"eu":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"fa":         {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"fa-AF":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"fi":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"fil":        {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr":         {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-BE":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-BF":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-BI":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-BJ":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-BL":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-CA":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-CD":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-CF":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-CG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-CH":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-CI":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-CM":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-DJ":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-DZ":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-GA":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-GF":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-GN":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-GP":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-GQ":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-HT":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-KM":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-LU":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-MA":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-MC":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-MF":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-MG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-ML":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-MQ":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-MR":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-MU":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-NC":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-NE":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-PF":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-PM":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-RE":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-RW":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-SC":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-SN":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-SY":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-TD":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-TG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-TN":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-VU":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-WF":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"fr-YT":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"ga":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ga-GB":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"gl":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"gu":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"he":         {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"hi":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"hr":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"hr-BA":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"hu":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"hy":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"id":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"is":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"it":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"it-CH":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"it-SM":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"it-VA":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ja":         {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"jv":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ka":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"kk":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"km":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"kn":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ko":         {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"ko-KP":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"ky":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"lo":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"lt":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"lv":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"mk":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ml":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"mn":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"mr":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ms":         {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"ms-BN":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"ms-SG":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"my":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"nb":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"nb-SJ":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ne":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ne-IN":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"nl":         {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"nl-AW":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"nl-BE":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"nl-BQ":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"nl-CW":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"nl-SR":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"nl-SX":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"no":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"or":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pa":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pl":         {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"ps":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ps-PK":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pt":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pt-AO":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pt-CH":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pt-CV":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pt-GQ":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pt-GW":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pt-LU":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pt-MO":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pt-MZ":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pt-PT":      {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"pt-ST":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"pt-TL":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ro":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ro-MD":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"root":       {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ru":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ru-BY":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ru-KG":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ru-KZ":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ru-MD":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ru-UA":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sd":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"si":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sk":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sl":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"so":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"so-DJ":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"so-ET":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"so-KE":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sq":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sq-MK":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sq-XK":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sr":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sr-Cyrl-BA": {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sr-Cyrl-XK": {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sr-Latn":    {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sr-Latn-BA": {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sr-Latn-ME": {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sr-Latn-XK": {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sv":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sv-AX":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sv-FI":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sw":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sw-CD":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sw-KE":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"sw-UG":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ta":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ta-LK":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ta-MY":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ta-SG":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"te":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"th":         {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"tk":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"tr":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"tr-CY":      {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"uk":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ur":         {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"ur-IN":      {PlusSign: "‎+", AccountingPrefix: "", AccountingSuffix: ""},
+	"uz":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"vi":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+	"yue":        {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"zh":         {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"zh-Hans-SG": {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"zh-Hant":    {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"zh-Hant-HK": {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"zh-Hant-MO": {PlusSign: "+", AccountingPrefix: "(", AccountingSuffix: ")"},
+	"zu":         {PlusSign: "+", AccountingPrefix: "", AccountingSuffix: ""},
+}
+
+// minimumGroupingDigitsByLanguage holds the number of digits a number must have before the first grouping separator
+// for it to be grouped, e.g. 2 in Spanish, where 1000 is "1000" but 10000 is "10.000".
+var minimumGroupingDigitsByLanguage = map[string]int{
+	"af":         1,
+	"af-NA":      1,
+	"am":         1,
+	"ar":         1,
+	"ar-AE":      1,
+	"ar-BH":      1,
+	"ar-DJ":      1,
+	"ar-DZ":      1,
+	"ar-EG":      1,
+	"ar-EH":      1,
+	"ar-ER":      1,
+	"ar-IL":      1,
+	"ar-IQ":      1,
+	"ar-JO":      1,
+	"ar-KM":      1,
+	"ar-KW":      1,
+	"ar-LB":      1,
+	"ar-LY":      1,
+	"ar-MA":      1,
+	"ar-MR":      1,
+	"ar-OM":      1,
+	"ar-PS":      1,
+	"ar-QA":      1,
+	"ar-SA":      1,
+	"ar-SD":      1,
+	"ar-SO":      1,
+	"ar-SS":      1,
+	"ar-SY":      1,
+	"ar-TD":      1,
+	"ar-TN":      1,
+	"ar-YE":      1,
+	"as":         1,
+	"az":         1,
+	"be":         1,
+	"bg":         2,
+	"bn":         1,
+	"bn-IN":      1,
+	"bs":         1,
+	"ca":         1,
+	"ca-AD":      1,
+	"ca-ES":      1,
+	"ca-FR":      1,
+	"ca-IT":      1,
+	"cs":         1,
+	"cy":         1,
+	"da":         1,
+	"da-GL":      1,
+	"de":         1,
+	"de-AT":      1,
+	"de-BE":      1,
+	"de-CH":      1,
+	"de-IT":      1,
+	"de-LI":      1,
+	"de-LU":      1,
+	"el":         1,
+	"el-CY":      1,
+	"en":         1,
+	"en-001":     1,
+	"en-150":     1,
+	"en-AE":      1,
+	"en-AG":      1,
+	"en-AI":      1,
+	"en-AS":      1,
+	"en-AT":      1,
+	"en-AU":      1,
+	"en-BB":      1,
+	"en-BE":      1,
+	"en-BI":      1,
+	"en-BM":      1,
+	"en-BS":      1,
+	"en-BW":      1,
+	"en-BZ":      1,
+	"en-CA":      1,
+	"en-CC":      1,
+	"en-CH":      1,
+	"en-CK":      1,
+	"en-CM":      1,
+	"en-CX":      1,
+	"en-CY":      1,
+	"en-DE":      1,
+	"en-DG":      1,
+	"en-DK":      1,
+	"en-DM":      1,
+	"en-ER":      1,
+	"en-FI":      1,
+	"en-FJ":      1,
+	"en-FK":      1,
+	"en-FM":      1,
+	"en-GB":      1,
+	"en-GD":      1,
+	"en-GG":      1,
+	"en-GH":      1,
+	"en-GI":      1,
+	"en-GM":      1,
+	"en-GU":      1,
+	"en-GY":      1,
+	"en-HK":      1,
+	"en-IE":      1,
+	"en-IL":      1,
+	"en-IM":      1,
+	"en-IN":      1,
+	"en-IO":      1,
+	"en-JE":      1,
+	"en-JM":      1,
+	"en-KE":      1,
+	"en-KI":      1,
+	"en-KN":      1,
+	"en-KY":      1,
+	"en-LC":      1,
+	"en-LR":      1,
+	"en-LS":      1,
+	"en-MG":      1,
+	"en-MH":      1,
+	"en-MO":      1,
+	"en-MP":      1,
+	"en-MS":      1,
+	"en-MT":      1,
+	"en-MU":      1,
+	"en-MW":      1,
+	"en-MY":      1,
+	"en-NA":      1,
+	"en-NF":      1,
+	"en-NG":      1,
+	"en-NL":      1,
+	"en-NR":      1,
+	"en-NU":      1,
+	"en-NZ":      1,
+	"en-PG":      1,
+	"en-PH":      1,
+	"en-PK":      1,
+	"en-PN":      1,
+	"en-PR":      1,
+	"en-PW":      1,
+	"en-RW":      1,
+	"en-SB":      1,
+	"en-SC":      1,
+	"en-SD":      1,
+	"en-SE":      1,
+	"en-SG":      1,
+	"en-SH":      1,
+	"en-SI":      1,
+	"en-SL":      1,
+	"en-SS":      1,
+	"en-SX":      1,
+	"en-SZ":      1,
+	"en-TC":      1,
+	"en-TK":      1,
+	"en-TO":      1,
+	"en-TT":      1,
+	"en-TV":      1,
+	"en-TZ":      1,
+	"en-UG":      1,
+	"en-UM":      1,
+	"en-US":      1,
+	"en-VC":      1,
+	"en-VG":      1,
+	"en-VI":      1,
+	"en-VU":      1,
+	"en-WS":      1,
+	"en-ZA":      1,
+	"en-ZM":      1,
+	"en-ZW":      1,
+	"es":         2,
+	"es-419":     1,
+	"es-AR":      1,
+	"es-BO":      1,
+	"es-BR":      1,
+	"es-BZ":      1,
+	"es-CL":      1,
+	"es-CO":      1,
+	"es-CR":      1,
+	"es-CU":      1,
+	"es-DO":      1,
+	"es-EA":      2,
+	"es-EC":      1,
+	"es-GQ":      2,
+	"es-GT":      1,
+	"es-HN":      1,
+	"es-IC":      2,
+	"es-MX":      1,
+	"es-NI":      1,
+	"es-PA":      1,
+	"es-PE":      1,
+	"es-PH":      2,
+	"es-PR":      1,
+	"es-PY":      1,
+	"es-SV":      1,
+	"es-US":      1,
+	"es-UY":      1,
+	"es-VE":      1,
+	"et":         1,
+	"eu":         1,
+	"fa":         1,
+	"fa-AF":      1,
+	"fi":         1,
+	"fil":        1,
+	"fr":         1,
+	"fr-BE":      1,
+	"fr-BF":      1,
+	"fr-BI":      1,
+	"fr-BJ":      1,
+	"fr-BL":      1,
+	"fr-CA":      1,
+	"fr-CD":      1,
+	"fr-CF":      1,
+	"fr-CG":      1,
+	"fr-CH":      1,
+	"fr-CI":      1,
+	"fr-CM":      1,
+	"fr-DJ":      1,
+	"fr-DZ":      1,
+	"fr-GA":      1,
+	"fr-GF":      1,
+	"fr-GN":      1,
+	"fr-GP":      1,
+	"fr-GQ":      1,
+	"fr-HT":      1,
+	"fr-KM":      1,
+	"fr-LU":      1,
+	"fr-MA":      1,
+	"fr-MC":      1,
+	"fr-MF":      1,
+	"fr-MG":      1,
+	"fr-ML":      1,
+	"fr-MQ":      1,
+	"fr-MR":      1,
+	"fr-MU":      1,
+	"fr-NC":      1,
+	"fr-NE":      1,
+	"fr-PF":      1,
+	"fr-PM":      1,
+	"fr-RE":      1,
+	"fr-RW":      1,
+	"fr-SC":      1,
+	"fr-SN":      1,
+	"fr-SY":      1,
+	"fr-TD":      1,
+	"fr-TG":      1,
+	"fr-TN":      1,
+	"fr-VU":      1,
+	"fr-WF":      1,
+	"fr-YT":      1,
+	"ga":         1,
+	"ga-GB":      1,
+	"gl":         1,
+	"gu":         1,
+	"he":         1,
+	"hi":         1,
+	"hr":         1,
+	"hr-BA":      1,
+	"hu":         1,
+	"hy":         1,
+	"id":         1,
+	"is":         1,
+	"it":         1,
+	"it-CH":      1,
+	"it-SM":      1,
+	"it-VA":      1,
+	"ja":         1,
+	"jv":         1,
+	"ka":         1,
+	"kk":         1,
+	"km":         1,
+	"kn":         1,
+	"ko":         1,
+	"ko-KP":      1,
+	"ky":         1,
+	"lo":         1,
+	"lt":         1,
+	"lv":         1,
+	"mk":         1,
+	"ml":         1,
+	"mn":         1,
+	"mr":         1,
+	"ms":         1,
+	"ms-BN":      1,
+	"ms-SG":      1,
+	"my":         1,
+	"nb":         1,
+	"nb-SJ":      1,
+	"ne":         1,
+	"ne-IN":      1,
+	"nl":         1,
+	"nl-AW":      1,
+	"nl-BE":      1,
+	"nl-BQ":      1,
+	"nl-CW":      1,
+	"nl-SR":      1,
+	"nl-SX":      1,
+	"no":         1,
+	"or":         1,
+	"pa":         1,
+	"pl":         2,
+	"ps":         1,
+	"ps-PK":      1,
+	"pt":         1,
+	"pt-AO":      2,
+	"pt-CH":      2,
+	"pt-CV":      2,
+	"pt-GQ":      2,
+	"pt-GW":      2,
+	"pt-LU":      2,
+	"pt-MO":      2,
+	"pt-MZ":      2,
+	"pt-PT":      2,
+	"pt-ST":      2,
+	"pt-TL":      2,
+	"ro":         1,
+	"ro-MD":      1,
+	"root":       1,
+	"ru":         1,
+	"ru-BY":      1,
+	"ru-KG":      1,
+	"ru-KZ":      1,
+	"ru-MD":      1,
+	"ru-UA":      1,
+	"sd":         1,
+	"si":         1,
+	"sk":         1,
+	"sl":         1,
+	"so":         1,
+	"so-DJ":      1,
+	"so-ET":      1,
+	"so-KE":      1,
+	"sq":         1,
+	"sq-MK":      1,
+	"sq-XK":      1,
+	"sr":         1,
+	"sr-Cyrl-BA": 1,
+	"sr-Cyrl-XK": 1,
+	"sr-Latn":    1,
+	"sr-Latn-BA": 1,
+	"sr-Latn-ME": 1,
+	"sr-Latn-XK": 1,
+	"sv":         1,
+	"sv-AX":      1,
+	"sv-FI":      1,
+	"sw":         1,
+	"sw-CD":      1,
+	"sw-KE":      1,
+	"sw-UG":      1,
+	"ta":         1,
+	"ta-LK":      1,
+	"ta-MY":      1,
+	"ta-SG":      1,
+	"te":         1,
+	"th":         1,
+	"tk":         1,
+	"tr":         1,
+	"tr-CY":      1,
+	"uk":         1,
+	"ur":         1,
+	"ur-IN":      1,
+	"uz":         1,
+	"vi":         1,
+	"yue":        1,
+	"zh":         1,
+	"zh-Hans-SG": 1,
+	"zh-Hant":    1,
+	"zh-Hant-HK": 1,
+	"zh-Hant-MO": 1,
+	"zu":         1,
+}
+
+// pluralRangesByLanguage holds the plural ranges of the languages that have them.
+var pluralRangesByLanguage = map[string]models.PluralRanges{
+	"ar": {
+		"few":   {"few": "few", "many": "many", "other": "other"},
+		"many":  {"few": "few", "many": "many", "other": "other"},
+		"one":   {"few": "few", "many": "many", "other": "other", "two": "other"},
+		"other": {"few": "few", "many": "many", "one": "other", "other": "other", "two": "other"},
+		"two":   {"few": "few", "many": "many", "other": "other"},
+		"zero":  {"few": "few", "many": "many", "one": "zero", "other": "other", "two": "zero"},
+	},
+	"bn": {
+		"one":   {"one": "one", "other": "other"},
+		"other": {"other": "other"},
+	},
+	"ca": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"cs": {
+		"few":   {"few": "few", "many": "many", "one": "one", "other": "other"},
+		"many":  {"few": "few", "many": "many", "one": "one", "other": "other"},
+		"one":   {"few": "few", "many": "many", "other": "other"},
+		"other": {"few": "few", "many": "many", "one": "one", "other": "other"},
+	},
+	"da": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"de": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"el": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"en": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"es": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"et": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"fa": {
+		"one":   {"one": "one", "other": "other"},
+		"other": {"other": "other"},
+	},
+	"fi": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"fr": {
+		"one":   {"one": "one", "other": "other"},
+		"other": {"other": "other"},
+	},
+	"gu": {
+		"one":   {"one": "one", "other": "other"},
+		"other": {"other": "other"},
+	},
+	"he": {
+		"many":  {"many": "many", "other": "many"},
+		"one":   {"many": "many", "other": "other", "two": "other"},
+		"other": {"many": "many", "one": "other", "other": "other", "two": "other"},
+		"two":   {"many": "other", "other": "other"},
+	},
+	"hi": {
+		"one":   {"one": "one", "other": "other"},
+		"other": {"other": "other"},
+	},
+	"id": {
+		"other": {"other": "other"},
+	},
+	"it": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"ja": {
+		"other": {"other": "other"},
+	},
+	"km": {
+		"other": {"other": "other"},
+	},
+	"ko": {
+		"other": {"other": "other"},
+	},
+	"lo": {
+		"other": {"other": "other"},
+	},
+	"lv": {
+		"one":   {"one": "one", "other": "other", "zero": "zero"},
+		"other": {"one": "one", "other": "other", "zero": "zero"},
+		"zero":  {"one": "one", "other": "other", "zero": "zero"},
+	},
+	"ms": {
+		"other": {"other": "other"},
+	},
+	"my": {
+		"other": {"other": "other"},
+	},
+	"nb": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"nl": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"no": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"pl": {
+		"few":   {"few": "few", "many": "many", "one": "one", "other": "other"},
+		"many":  {"few": "few", "many": "many", "one": "one", "other": "other"},
+		"one":   {"few": "few", "many": "many", "other": "other"},
+		"other": {"few": "few", "many": "many", "one": "one", "other": "other"},
+	},
+	"pt": {
+		"one":   {"one": "one", "other": "other"},
+		"other": {"other": "other"},
+	},
+	"ro": {
+		"few":   {"few": "few", "one": "few", "other": "other"},
+		"one":   {"few": "few", "other": "other"},
+		"other": {"few": "few", "other": "other"},
+	},
+	"ru": {
+		"few":   {"few": "few", "many": "many", "one": "one", "other": "other"},
+		"many":  {"few": "few", "many": "many", "one": "one", "other": "other"},
+		"one":   {"few": "few", "many": "many", "one": "one", "other": "other"},
+		"other": {"few": "few", "many": "many", "one": "one", "other": "other"},
+	},
+	"sk": {
+		"few":   {"few": "few", "many": "many", "one": "one", "other": "other"},
+		"many":  {"few": "few", "many": "many", "one": "one", "other": "other"},
+		"one":   {"few": "few", "many": "many", "other": "other"},
+		"other": {"few": "few", "many": "many", "one": "one", "other": "other"},
+	},
+	"sv": {
+		"one":   {"other": "other"},
+		"other": {"one": "one", "other": "other"},
+	},
+	"th": {
+		"other": {"other": "other"},
+	},
+	"uk": {
+		"few":   {"few": "few", "many": "many", "one": "one", "other": "other"},
+		"many":  {"few": "few", "many": "many", "one": "one", "other": "other"},
+		"one":   {"few": "few", "many": "many", "one": "one", "other": "other"},
+		"other": {"few": "few", "many": "many", "one": "one", "other": "other"},
+	},
+	"vi": {
+		"other": {"other": "other"},
+	},
+	"yue": {
+		"other": {"other": "other"},
+	},
+	"zh": {
+		"other": {"other": "other"},
+	},
+}
@@ -0,0 +1,127 @@
+// Package compact contains the parts of the CLDR compact number algorithm that operate on the extracted rule tables.
+// It is shared by the compactnumber package and the generator, which uses it to render samples of data it has not
+// compiled in.
+package compact
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gotnospirit/makeplural/plural"
+	"github.com/nkall/compactnumber/internal/models"
+	"golang.org/x/text/language"
+)
+
+// DataKeys returns the keys the data of a language may be found under, from the most to the least specific: the exact tag, its
+// base language with its script and region, with its script, and alone. Languages written in several scripts have
+// data per script, so zh-TW is found under zh-Hant and sr-Latn-RS under sr-Latn, while zh-CN is found under zh.
+func DataKeys(lang language.Tag) []string {
+	keys := []string{lang.String()}
+	base, confidence := lang.Base()
+	if confidence == language.No {
+		return keys
+	}
+
+	var candidates []string
+	if script, confidence := lang.Script(); confidence != language.No {
+		if region, confidence := lang.Region(); confidence != language.No {
+			candidates = append(candidates, base.String()+"-"+script.String()+"-"+region.String())
+		}
+		candidates = append(candidates, base.String()+"-"+script.String())
+	}
+	candidates = append(candidates, base.String())
+
+	for _, candidate := range candidates {
+		if candidate != keys[len(keys)-1] {
+			keys = append(keys, candidate)
+		}
+	}
+
+	return keys
+}
+
+// SelectRule returns the rule with the greatest type less than or equal to n. The zero rule is returned if n is
+// smaller than every type.
+func SelectRule(rules []models.CompactFormRule, n int64) models.CompactFormRule {
+	var rule models.CompactFormRule
+	for _, compactFormRule := range rules {
+		if n >= compactFormRule.Type {
+			rule = compactFormRule
+		} else {
+			break
+		}
+	}
+
+	return rule
+}
+
+// Divisor returns the number N is divided by when formatted with the rule: its type, after removing the number of
+// zeros in the pattern, less 1.
+func Divisor(rule models.CompactFormRule) int64 {
+	typeDivisor := rule.Type
+	for i := 0; i < rule.ZeroesInPattern-1; i++ {
+		typeDivisor /= 10
+	}
+
+	return typeDivisor
+}
+
+// PluralForm gets the pluralized form of the number, as per CLDR spec: http://cldr.unicode.org/index/cldr-spec/plural-rules
+// We use gotnospirit/makeplural for this as golang.org/x/text/plural does not expose a suitable PluralForm method.
+// This is a best effort function since the languages might not match up perfectly between packages.
+func PluralForm(lang language.Tag, n interface{}) string {
+	pluralForm, _ := LookupPluralForm(lang, n)
+	return pluralForm
+}
+
+// LookupPluralForm gets the pluralized form of the number like PluralForm, reporting whether the language has plural
+// rules. Without them, the form is "other".
+func LookupPluralForm(lang language.Tag, n interface{}) (string, bool) {
+	base, confidence := lang.Base()
+	if confidence == language.No {
+		return "other", false
+	}
+
+	plurFunc, err := plural.GetFunc(base.String())
+	if err != nil {
+		return "other", false
+	}
+
+	return plurFunc(n, false), true
+}
+
+// SprintfPattern processes a CLDR pattern to a format suitable for use in Printer.Sprintf in golang.org/x/text/message.
+// Documentation for these special characters can be found in the CLDR spec: http://cldr.unicode.org/translation/number-patterns
+func SprintfPattern(pattern string) (string, error) {
+	// Default to 0 (sentinel value for no pattern). A pattern of precisely "0" means the same.
+	if pattern == "" || pattern == "0" {
+		return "0", nil
+	}
+
+	// Default to the first form if there's multiple
+	pattern = strings.Split(pattern, ";")[0]
+
+	// Remove special pattern symbols, as this formatting is already handled by golang.org/x/text/message
+	pattern = strings.Replace(pattern, "'", "", -1)
+
+	// Replace all 0s with a single %v for number formatting
+	zeroIndex := strings.IndexRune(pattern, '0')
+	if zeroIndex == -1 {
+		return "", errors.New(fmt.Sprintf("invalid pattern (no digit pattern characters): %s", pattern))
+	}
+
+	pattern = fmt.Sprintf("%s%s%s", pattern[:zeroIndex], "%v", strings.Replace(pattern[zeroIndex:], "0", "", -1))
+	return pattern, nil
+}
+
+// PluralRangeForm gets the plural form of a range of numbers from the plural forms of its start and end, as per CLDR
+// spec: https://www.unicode.org/reports/tr35/tr35-numbers.html#Plural_Ranges
+// Ranges of languages without plural ranges, and unexpected pairs of plural forms, use the plural form of the end.
+func PluralRangeForm(ranges models.PluralRanges, start string, end string) string {
+	if pluralForm, ok := ranges[start][end]; ok {
+		return pluralForm
+	}
+
+	return end
+}
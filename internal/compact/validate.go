@@ -0,0 +1,239 @@
+package compact
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gotnospirit/makeplural/plural"
+	"github.com/nkall/compactnumber/internal/models"
+	"golang.org/x/text/language"
+)
+
+// Severity indicates whether a finding makes the rules unusable or is merely suspicious.
+type Severity string
+
+const (
+	// Error findings break formatting for some numbers.
+	Error = Severity("error")
+	// Warning findings indicate data that is likely not what CLDR intended, but still formats.
+	Warning = Severity("warning")
+)
+
+// Check identifies the validation that produced a finding.
+type Check string
+
+const (
+	// Types must strictly increase within a compact type.
+	CheckTypeOrder = Check("type-order")
+	// Types must be powers of ten.
+	CheckTypePowerOfTen = Check("type-power-of-ten")
+	// Every rule must have an "other" pattern.
+	CheckMissingOther = Check("missing-other")
+	// The plural categories of a rule must match the ones the locale's plural rules select.
+	CheckPluralCategories = Check("plural-categories")
+	// ZeroesInPattern must match the number of zeroes in every pattern of the rule.
+	CheckZeroesInPattern = Check("zeroes-in-pattern")
+	// Every compact type must have rules for the same types.
+	CheckMagnitudeCoverage = Check("magnitude-coverage")
+)
+
+// pluralCategoryOrder lists the CLDR plural categories in their canonical order.
+var pluralCategoryOrder = []string{"zero", "one", "two", "few", "many", "other"}
+
+// Finding is a single problem found in the rules of a locale. CompactType, Type and PluralForm are empty when the
+// problem is not specific to them.
+type Finding struct {
+	Locale      string
+	CompactType string
+	Type        int64
+	PluralForm  string
+	Check       Check
+	Severity    Severity
+	Message     string
+}
+
+func (f Finding) String() string {
+	location := f.Locale
+	if f.CompactType != "" {
+		location += " " + f.CompactType
+	}
+	if f.Type != 0 {
+		location += " " + strconv.FormatInt(f.Type, 10)
+	}
+	if f.PluralForm != "" {
+		location += " " + f.PluralForm
+	}
+	return fmt.Sprintf("%s: %s [%s %s]", location, f.Message, f.Severity, f.Check)
+}
+
+// Validate checks the compact forms of a locale, keyed by compact type, and returns its findings ordered by compact
+// type, then by rule.
+func Validate(locale string, forms map[string][]models.CompactFormRule) []Finding {
+	compactTypes := make([]string, 0, len(forms))
+	for compactType := range forms {
+		compactTypes = append(compactTypes, compactType)
+	}
+	sort.Strings(compactTypes)
+
+	categories := pluralCategories(language.Make(locale))
+
+	var findings []Finding
+	for _, compactType := range compactTypes {
+		findings = append(findings, validateRules(locale, compactType, forms[compactType], categories)...)
+	}
+
+	return append(findings, validateCoverage(locale, forms, compactTypes)...)
+}
+
+func validateRules(locale string, compactType string, rules []models.CompactFormRule, categories map[int]map[string]bool) []Finding {
+	var findings []Finding
+	add := func(rule models.CompactFormRule, pluralForm string, check Check, severity Severity, format string, args ...interface{}) {
+		findings = append(findings, Finding{
+			Locale:      locale,
+			CompactType: compactType,
+			Type:        rule.Type,
+			PluralForm:  pluralForm,
+			Check:       check,
+			Severity:    severity,
+			Message:     fmt.Sprintf(format, args...),
+		})
+	}
+
+	for i, rule := range rules {
+		if !isPowerOfTen(rule.Type) {
+			add(rule, "", CheckTypePowerOfTen, Error, "type is not a power of ten")
+		}
+		if i > 0 && rule.Type <= rules[i-1].Type {
+			add(rule, "", CheckTypeOrder, Error, "type does not increase from previous type %d", rules[i-1].Type)
+		}
+
+		other, ok := rule.PatternsByPluralForm["other"]
+		if !ok {
+			add(rule, "", CheckMissingOther, Error, "no \"other\" pattern to fall back to")
+		}
+
+		for _, pluralForm := range sortedKeys(rule.PatternsByPluralForm) {
+			pattern := rule.PatternsByPluralForm[pluralForm]
+			if zeroes := CountZeroes(pattern); zeroes != rule.ZeroesInPattern {
+				add(rule, pluralForm, CheckZeroesInPattern, Error, "pattern %q has %d zeroes, but ZeroesInPattern is %d", pattern, zeroes, rule.ZeroesInPattern)
+			}
+		}
+
+		// The "0" pattern formats the whole number, so plural categories don't apply to it.
+		if categories == nil || other == "0" {
+			continue
+		}
+
+		for _, pluralForm := range sortedKeys(rule.PatternsByPluralForm) {
+			if !categories[0][pluralForm] {
+				add(rule, pluralForm, CheckPluralCategories, Warning, "plural category is not used by the locale's plural rules")
+			}
+		}
+		reachable := categories[rule.ZeroesInPattern]
+		for _, category := range pluralCategoryOrder {
+			if _, ok := rule.PatternsByPluralForm[category]; reachable[category] && !ok {
+				add(rule, category, CheckPluralCategories, Warning, "no pattern for plural category, falling back to \"other\"")
+			}
+		}
+	}
+
+	return findings
+}
+
+func validateCoverage(locale string, forms map[string][]models.CompactFormRule, compactTypes []string) []Finding {
+	var findings []Finding
+	for _, compactType := range compactTypes {
+		for _, otherType := range compactTypes {
+			if compactType == otherType {
+				continue
+			}
+
+			otherTypes := make(map[int64]bool)
+			for _, rule := range forms[otherType] {
+				otherTypes[rule.Type] = true
+			}
+			for _, rule := range forms[compactType] {
+				if !otherTypes[rule.Type] {
+					findings = append(findings, Finding{
+						Locale:      locale,
+						CompactType: compactType,
+						Type:        rule.Type,
+						Check:       CheckMagnitudeCoverage,
+						Severity:    Warning,
+						Message:     fmt.Sprintf("type has no %s rule", otherType),
+					})
+				}
+			}
+		}
+	}
+
+	return findings
+}
+
+// pluralCategories returns the plural categories the locale's rules select for integers, keyed by their number of
+// digits (1 to 3, the most a compact pattern can have). Key 0 holds every category of the locale, including the ones
+// only selected for fractions. It returns nil if the locale has no known plural rules.
+func pluralCategories(lang language.Tag) map[int]map[string]bool {
+	base, confidence := lang.Base()
+	if confidence == language.No {
+		return nil
+	}
+
+	plurFunc, err := plural.GetFunc(base.String())
+	if err != nil {
+		return nil
+	}
+
+	categories := map[int]map[string]bool{0: make(map[string]bool)}
+	for _, sample := range []string{"0.0", "0.1", "0.5", "1.0", "1.1", "1.5", "2.0", "2.1", "2.5", "3.5", "5.5", "10.1", "11.5", "1000000"} {
+		categories[0][plurFunc(sample, false)] = true
+	}
+	for digits, min := 1, 1; digits <= 3; digits, min = digits+1, min*10 {
+		categories[digits] = make(map[string]bool)
+		for n := min; n < min*10; n++ {
+			category := plurFunc(n, false)
+			categories[digits][category] = true
+			categories[0][category] = true
+		}
+	}
+
+	return categories
+}
+
+func isPowerOfTen(n int64) bool {
+	if n < 1 {
+		return false
+	}
+	for n%10 == 0 {
+		n /= 10
+	}
+	return n == 1
+}
+
+// CountZeroes counts the digit placeholders of a pattern, ignoring quoted literals and the negative subpattern.
+func CountZeroes(pattern string) int {
+	pattern = strings.Split(pattern, ";")[0]
+
+	zeroes := 0
+	quoted := false
+	for _, r := range pattern {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == '0' && !quoted:
+			zeroes++
+		}
+	}
+	return zeroes
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
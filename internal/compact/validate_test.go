@@ -0,0 +1,130 @@
+package compact_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nkall/compactnumber/internal/compact"
+	"github.com/nkall/compactnumber/internal/models"
+)
+
+func TestValidate(t *testing.T) {
+	thousand := models.CompactFormRule{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"one": "0K", "other": "0K"}}
+	million := models.CompactFormRule{Type: 1000000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"one": "0M", "other": "0M"}}
+
+	tests := []struct {
+		name     string
+		locale   string
+		forms    map[string][]models.CompactFormRule
+		expected []string
+	}{
+		{
+			name:   "valid",
+			locale: "en",
+			forms:  map[string][]models.CompactFormRule{"Short": {thousand, million}, "Long": {thousand, million}},
+		},
+		{
+			name:   "type not a power of ten",
+			locale: "en",
+			forms: map[string][]models.CompactFormRule{"Short": {
+				thousand,
+				{Type: 2000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"one": "0K", "other": "0K"}},
+			}},
+			expected: []string{"en Short 2000: type is not a power of ten [error type-power-of-ten]"},
+		},
+		{
+			name:     "type order",
+			locale:   "en",
+			forms:    map[string][]models.CompactFormRule{"Short": {million, thousand}},
+			expected: []string{"en Short 1000: type does not increase from previous type 1000000 [error type-order]"},
+		},
+		{
+			name:   "missing other",
+			locale: "en",
+			forms: map[string][]models.CompactFormRule{"Short": {
+				{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"one": "0K"}},
+			}},
+			expected: []string{
+				`en Short 1000: no "other" pattern to fall back to [error missing-other]`,
+				`en Short 1000 other: no pattern for plural category, falling back to "other" [warning plural-categories]`,
+			},
+		},
+		{
+			name:   "zeroes in pattern",
+			locale: "en",
+			forms: map[string][]models.CompactFormRule{"Short": {
+				{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"one": "00K", "other": "0K"}},
+			}},
+			expected: []string{`en Short 1000 one: pattern "00K" has 2 zeroes, but ZeroesInPattern is 1 [error zeroes-in-pattern]`},
+		},
+		{
+			name:   "invalid plural category",
+			locale: "en",
+			forms: map[string][]models.CompactFormRule{"Short": {
+				{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"few": "0K", "one": "0K", "other": "0K"}},
+			}},
+			expected: []string{"en Short 1000 few: plural category is not used by the locale's plural rules [warning plural-categories]"},
+		},
+		{
+			name:   "missing plural categories",
+			locale: "ru",
+			forms: map[string][]models.CompactFormRule{"Short": {
+				{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"other": "0 тыс."}},
+			}},
+			expected: []string{
+				`ru Short 1000 one: no pattern for plural category, falling back to "other" [warning plural-categories]`,
+				`ru Short 1000 few: no pattern for plural category, falling back to "other" [warning plural-categories]`,
+				`ru Short 1000 many: no pattern for plural category, falling back to "other" [warning plural-categories]`,
+			},
+		},
+		{
+			name:   "uncompacted rule",
+			locale: "ru",
+			forms: map[string][]models.CompactFormRule{"Short": {
+				{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"other": "0"}},
+			}},
+		},
+		{
+			name:   "locale without plural rules",
+			locale: "tlh",
+			forms: map[string][]models.CompactFormRule{"Short": {
+				{Type: 1000, ZeroesInPattern: 1, PatternsByPluralForm: map[string]string{"few": "0K", "other": "0K"}},
+			}},
+		},
+		{
+			name:     "magnitude coverage",
+			locale:   "en",
+			forms:    map[string][]models.CompactFormRule{"Short": {thousand, million}, "Long": {thousand}},
+			expected: []string{"en Short 1000000: type has no Long rule [warning magnitude-coverage]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var findings []string
+			for _, finding := range compact.Validate(tt.locale, tt.forms) {
+				findings = append(findings, finding.String())
+			}
+			if !reflect.DeepEqual(findings, tt.expected) {
+				t.Errorf("got unexpected findings %q (wanted %q)", findings, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCountZeroes(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expected int
+	}{
+		{pattern: "0K", expected: 1},
+		{pattern: "000 тыс.", expected: 3},
+		// Quoted literals and the negative subpattern are not counted
+		{pattern: "0 '0'", expected: 1},
+		{pattern: "00K;-00K", expected: 2},
+	}
+	for _, tt := range tests {
+		if zeroes := compact.CountZeroes(tt.pattern); zeroes != tt.expected {
+			t.Errorf("got %d zeroes in %s (wanted %d)", zeroes, tt.pattern, tt.expected)
+		}
+	}
+}
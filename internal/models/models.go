@@ -8,3 +8,25 @@ type CompactFormRule struct {
 	ZeroesInPattern      int
 	PatternsByPluralForm map[string]string
 }
+
+// PluralRanges maps the plural forms of the start and end of a range of numbers, in that order, to the plural form of
+// the whole range, e.g. "one" to "other" is "other" in English.
+type PluralRanges map[string]map[string]string
+
+// MiscPatterns are the miscellaneous number patterns of a locale, in which {0} stands for a formatted number. The
+// range pattern also has {1} for the end of the range.
+type MiscPatterns struct {
+	Approximately string
+	AtLeast       string
+	AtMost        string
+	Range         string
+}
+
+// Signs are the symbols a locale marks the sign of numbers with, other than the minus sign, which is handled by
+// golang.org/x/text. Negative numbers in accounting format are wrapped in AccountingPrefix and AccountingSuffix, or
+// get a minus sign if both are empty.
+type Signs struct {
+	PlusSign         string
+	AccountingPrefix string
+	AccountingSuffix string
+}
@@ -0,0 +1,142 @@
+package compactnumber
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// IntlOptions are the options of JavaScript's Intl.NumberFormat that Format supports, so a server can format numbers
+// like a browser given the same options object, e.g.
+//
+//	{"notation": "compact", "compactDisplay": "long", "maximumSignificantDigits": 2, "signDisplay": "exceptZero"}
+//
+// Empty options take Intl.NumberFormat's defaults. The compact notation rounds numbers to two significant digits when
+// their mantissa is below 100 and to integers otherwise, and does not group numbers with fewer than five digits, as
+// Intl.NumberFormat does.
+//
+// See https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Intl/NumberFormat/NumberFormat
+type IntlOptions struct {
+	// Only "decimal" is supported.
+	Style string `json:"style,omitempty"`
+	// "standard" or "compact".
+	Notation string `json:"notation,omitempty"`
+	// "short" or "long".
+	CompactDisplay string `json:"compactDisplay,omitempty"`
+	// "auto", "always", "exceptZero", "negative" or "never".
+	SignDisplay string `json:"signDisplay,omitempty"`
+	// "ceil", "floor", "expand", "trunc", "halfExpand", "halfTrunc" or "halfEven".
+	RoundingMode string `json:"roundingMode,omitempty"`
+	// From 1 to 21. It takes precedence over MaximumFractionDigits.
+	MaximumSignificantDigits *int `json:"maximumSignificantDigits,omitempty"`
+	// From 0 to 100.
+	MaximumFractionDigits *int `json:"maximumFractionDigits,omitempty"`
+}
+
+// The formatter settings of the Intl.NumberFormat options with a fixed set of values.
+var (
+	intlSignDisplays = map[string]SignDisplay{
+		"auto":       SignAuto,
+		"always":     SignAlways,
+		"exceptZero": SignExceptZero,
+		// Integers are never negative zero, the only number "negative" shows differently than "auto"
+		"negative": SignAuto,
+		"never":    SignNever,
+	}
+	intlRoundingModes = map[string]RoundingMode{
+		"ceil":       RoundCeiling,
+		"floor":      RoundFloor,
+		"expand":     RoundUp,
+		"trunc":      RoundDown,
+		"halfExpand": RoundHalfUp,
+		"halfTrunc":  RoundHalfDown,
+		"halfEven":   RoundHalfEven,
+	}
+)
+
+// ParseIntlOptions parses an Intl.NumberFormat options object from JSON. An error is returned for options Format
+// does not support.
+func ParseIntlOptions(b []byte) (IntlOptions, error) {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+
+	var options IntlOptions
+	if err := decoder.Decode(&options); err != nil {
+		return IntlOptions{}, errors.New(fmt.Sprintf("invalid Intl.NumberFormat options: %s", err))
+	}
+
+	return options, nil
+}
+
+// NewIntlFormatter creates a formatter for the locale configured by Intl.NumberFormat options. An error is returned
+// for unsupported values.
+func NewIntlFormatter(lang string, options IntlOptions) (Formatter, error) {
+	if options.Style != "" && options.Style != "decimal" {
+		return Formatter{}, errors.New(fmt.Sprintf("unsupported Intl.NumberFormat style %q", options.Style))
+	}
+
+	compactType := None
+	switch options.Notation {
+	case "", "standard":
+		if options.CompactDisplay != "" {
+			return Formatter{}, errors.New("Intl.NumberFormat compactDisplay requires the compact notation")
+		}
+	case "compact":
+		switch options.CompactDisplay {
+		case "", "short":
+			compactType = Short
+		case "long":
+			compactType = Long
+		default:
+			return Formatter{}, errors.New(fmt.Sprintf("unsupported Intl.NumberFormat compactDisplay %q", options.CompactDisplay))
+		}
+	default:
+		return Formatter{}, errors.New(fmt.Sprintf("unsupported Intl.NumberFormat notation %q", options.Notation))
+	}
+
+	formatterOptions := []FormatterOption{Round(RoundHalfUp)}
+
+	if options.SignDisplay != "" {
+		display, ok := intlSignDisplays[options.SignDisplay]
+		if !ok {
+			return Formatter{}, errors.New(fmt.Sprintf("unsupported Intl.NumberFormat signDisplay %q", options.SignDisplay))
+		}
+		formatterOptions = append(formatterOptions, DisplaySign(display))
+	}
+
+	if options.RoundingMode != "" {
+		mode, ok := intlRoundingModes[options.RoundingMode]
+		if !ok {
+			return Formatter{}, errors.New(fmt.Sprintf("unsupported Intl.NumberFormat roundingMode %q", options.RoundingMode))
+		}
+		formatterOptions = append(formatterOptions, Round(mode))
+	}
+
+	switch {
+	case options.MaximumSignificantDigits != nil && (*options.MaximumSignificantDigits < 1 || *options.MaximumSignificantDigits > 21):
+		return Formatter{}, errors.New(fmt.Sprintf("Intl.NumberFormat maximumSignificantDigits %d is out of range", *options.MaximumSignificantDigits))
+	case options.MaximumFractionDigits != nil && (*options.MaximumFractionDigits < 0 || *options.MaximumFractionDigits > 100):
+		return Formatter{}, errors.New(fmt.Sprintf("Intl.NumberFormat maximumFractionDigits %d is out of range", *options.MaximumFractionDigits))
+	case options.MaximumSignificantDigits != nil:
+		formatterOptions = append(formatterOptions, SignificantDigits(*options.MaximumSignificantDigits))
+	case options.MaximumFractionDigits != nil:
+		formatterOptions = append(formatterOptions, FractionDigits(*options.MaximumFractionDigits))
+	case compactType != None:
+		formatterOptions = append(formatterOptions, Precision(PrecisionCompact))
+	default:
+		// Integers have no fraction digits to round
+		formatterOptions = append(formatterOptions, FractionDigits(0))
+	}
+
+	f := NewFormatter(lang, compactType, formatterOptions...)
+
+	// Numbers are grouped as per the locale's minimum grouping digits, and at least two with the compact notation, like
+	// ICU's "min2" grouping strategy
+	f.minimumGroupingDigits = lookupMinimumGroupingDigits(f.lang)
+	if compactType != None && f.minimumGroupingDigits < 2 {
+		f.minimumGroupingDigits = 2
+	}
+
+	return f, nil
+}
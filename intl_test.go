@@ -0,0 +1,131 @@
+package compactnumber_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+)
+
+func TestNewIntlFormatter(t *testing.T) {
+	tests := []struct {
+		localeStr   string
+		options     string
+		n           int
+		expectedOut string
+	}{
+		{localeStr: "en-US", options: `{"notation": "compact"}`, n: 1234, expectedOut: "1.2K"},
+		{localeStr: "en-US", options: `{"notation": "compact"}`, n: 12345, expectedOut: "12K"},
+		{localeStr: "en-US", options: `{"notation": "compact"}`, n: 123456, expectedOut: "123K"},
+		{localeStr: "en-US", options: `{"notation": "compact"}`, n: 999999, expectedOut: "1M"},
+		{localeStr: "en-US", options: `{"notation": "compact", "compactDisplay": "long", "maximumSignificantDigits": 2}`, n: 1250000, expectedOut: "1.3 million"},
+		{localeStr: "en-US", options: `{"notation": "compact", "roundingMode": "trunc", "signDisplay": "always"}`, n: 1999, expectedOut: "+1.9K"},
+		{localeStr: "en-US", options: `{"notation": "compact", "maximumFractionDigits": 0, "signDisplay": "exceptZero"}`, n: 0, expectedOut: "0"},
+		{localeStr: "en-US", options: `{"maximumSignificantDigits": 2}`, n: 1234, expectedOut: "1,200"},
+		{localeStr: "en-US", options: `{}`, n: 1234, expectedOut: "1,234"},
+		// Compact notation does not group numbers with fewer than five digits
+		{localeStr: "ja", options: `{"notation": "compact"}`, n: 1234, expectedOut: "1234"},
+		{localeStr: "ja", options: `{"notation": "compact"}`, n: 12345, expectedOut: "1.2万"},
+		{localeStr: "de", options: `{"notation": "compact"}`, n: 123456, expectedOut: "123.456"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%d", tt.localeStr, tt.options, tt.n), func(t *testing.T) {
+			options, err := compactnumber.ParseIntlOptions([]byte(tt.options))
+			if err != nil {
+				t.Fatal(err)
+			}
+			formatter, err := compactnumber.NewIntlFormatter(tt.localeStr, options)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			out, err := formatter.Format(tt.n)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+}
+
+func TestNewIntlFormatterErrors(t *testing.T) {
+	tests := []string{
+		`{"useGrouping": false}`,
+		`{"style": "currency"}`,
+		`{"notation": "scientific"}`,
+		`{"compactDisplay": "long"}`,
+		`{"notation": "compact", "compactDisplay": "narrow"}`,
+		`{"signDisplay": "sometimes"}`,
+		`{"roundingMode": "halfCeil"}`,
+		`{"maximumSignificantDigits": 0}`,
+		`{"maximumSignificantDigits": 22}`,
+		`{"maximumSignificantDigits": 0, "maximumFractionDigits": 1}`,
+		`{"maximumFractionDigits": -1}`,
+	}
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			options, err := compactnumber.ParseIntlOptions([]byte(tt))
+			if err == nil {
+				_, err = compactnumber.NewIntlFormatter("en-US", options)
+			}
+			if err == nil {
+				t.Error("expected an error for unsupported options")
+			}
+		})
+	}
+}
+
+// intlCorpus is a set of outputs of Intl.NumberFormat recorded by testdata/intl.js. Cases that are known to differ
+// from this package carry the key of the reason in Differences.
+type intlCorpus struct {
+	Source      string            `json:"source"`
+	Differences map[string]string `json:"differences"`
+	Cases       []struct {
+		Locale     string          `json:"locale"`
+		Options    json.RawMessage `json:"options"`
+		N          int             `json:"n"`
+		Expected   string          `json:"expected"`
+		Difference string          `json:"difference"`
+	} `json:"cases"`
+}
+
+func TestIntlCorpus(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/intl.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var corpus intlCorpus
+	if err := json.Unmarshal(b, &corpus); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range corpus.Cases {
+		tt := tt
+		var name bytes.Buffer
+		if err := json.Compact(&name, tt.Options); err != nil {
+			t.Fatal(err)
+		}
+
+		t.Run(fmt.Sprintf("%s/%s/%d", tt.Locale, name.String(), tt.N), func(t *testing.T) {
+			options, err := compactnumber.ParseIntlOptions(tt.Options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			formatter, err := compactnumber.NewIntlFormatter(tt.Locale, options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, err := formatter.Format(tt.N)
+
+			if tt.Difference != "" {
+				if err == nil && out == tt.Expected {
+					t.Errorf("known difference %q no longer applies, remove it from testdata/intl.json", tt.Difference)
+				}
+				t.Skipf("known difference: %s", corpus.Differences[tt.Difference])
+			}
+
+			mustMatch(t, out, err, tt.Expected, nil)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package compactnumber
+
+import (
+	"sort"
+
+	"github.com/nkall/compactnumber/internal/compact"
+	"github.com/nkall/compactnumber/internal/models"
+	"golang.org/x/text/language"
+)
+
+// PluralCategory is a CLDR plural category, used to select the pattern of a compact form rule.
+// See http://cldr.unicode.org/index/cldr-spec/plural-rules
+type PluralCategory string
+
+// The plural categories defined by CLDR. Every locale uses "other", and a subset of the rest.
+const (
+	PluralZero  = PluralCategory("zero")
+	PluralOne   = PluralCategory("one")
+	PluralTwo   = PluralCategory("two")
+	PluralFew   = PluralCategory("few")
+	PluralMany  = PluralCategory("many")
+	PluralOther = PluralCategory("other")
+)
+
+// pluralCategoryOrder lists the plural categories in their canonical CLDR order.
+var pluralCategoryOrder = []PluralCategory{PluralZero, PluralOne, PluralTwo, PluralFew, PluralMany, PluralOther}
+
+// Rule is a compact form rule of a locale. Numbers greater than or equal to Type, and smaller than the type of the next
+// rule, are divided by Type after removing ZeroesInPattern - 1 zeroes from it, and formatted with the pattern of
+// their plural category. A pattern of "0" means numbers of this type are not compacted.
+type Rule struct {
+	Type            int64                     `json:"type"`
+	ZeroesInPattern int                       `json:"zeroesInPattern"`
+	Patterns        map[PluralCategory]string `json:"patterns"`
+}
+
+// PluralCategories returns the plural categories the rule has patterns for, in CLDR order.
+func (r Rule) PluralCategories() []PluralCategory {
+	categories := make([]PluralCategory, 0, len(r.Patterns))
+	for _, category := range pluralCategoryOrder {
+		if _, ok := r.Patterns[category]; ok {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// Divisor returns the number formatted values are divided by before being inserted into the pattern.
+func (r Rule) Divisor() int64 {
+	return compact.Divisor(r.toModel())
+}
+
+func (r Rule) toModel() models.CompactFormRule {
+	patterns := make(map[string]string, len(r.Patterns))
+	for category, pattern := range r.Patterns {
+		patterns[string(category)] = pattern
+	}
+
+	return models.CompactFormRule{
+		Type:                 r.Type,
+		ZeroesInPattern:      r.ZeroesInPattern,
+		PatternsByPluralForm: patterns,
+	}
+}
+
+// CLDRVersion returns the version of the CLDR data the compact forms were generated from.
+func CLDRVersion() string {
+	return cldrVersion
+}
+
+// SupportedLocales returns every locale with compact forms, sorted by their string representation. Formatters for
+// other tags are supported if their base language, alone or with their script, is in the list, e.g. zh-TW through
+// zh-Hant. The CLDR root locale is not included.
+func SupportedLocales() []language.Tag {
+	tags := make([]language.Tag, 0, len(compactFormsByLanguage))
+	for locale := range compactFormsByLanguage {
+		if locale != "root" {
+			tags = append(tags, language.Make(locale))
+		}
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].String() < tags[j].String() })
+
+	return tags
+}
+
+// IsSupported reports whether Format supports the tag, either directly or through its base language, alone or with its
+// script.
+func IsSupported(lang language.Tag) bool {
+	_, err := lookupCompactForms(lang)
+	return err == nil
+}
+
+// Rules returns the compact form rules Format uses for the tag and compaction type, ordered by type. None has no
+// rules. An error is returned if the tag is not supported.
+func Rules(lang language.Tag, compactType CompactType) ([]Rule, error) {
+	compactForms, err := lookupCompactForms(lang)
+	if err != nil {
+		return nil, err
+	}
+
+	modelRules := compactForms[compactType]
+	rules := make([]Rule, 0, len(modelRules))
+	for _, modelRule := range modelRules {
+		rule := Rule{
+			Type:            modelRule.Type,
+			ZeroesInPattern: modelRule.ZeroesInPattern,
+			Patterns:        make(map[PluralCategory]string, len(modelRule.PatternsByPluralForm)),
+		}
+		for pluralForm, pattern := range modelRule.PatternsByPluralForm {
+			rule.Patterns[PluralCategory(pluralForm)] = pattern
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
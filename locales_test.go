@@ -0,0 +1,91 @@
+package compactnumber_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+	"golang.org/x/text/language"
+)
+
+func TestCLDRVersion(t *testing.T) {
+	if version := compactnumber.CLDRVersion(); version != "36" {
+		t.Errorf("got unexpected CLDR version %s (wanted 36)", version)
+	}
+}
+
+func TestSupportedLocales(t *testing.T) {
+	locales := compactnumber.SupportedLocales()
+
+	found := false
+	for i, locale := range locales {
+		if i > 0 && locales[i-1].String() >= locale.String() {
+			t.Errorf("got unsorted locales %s, %s", locales[i-1], locale)
+		}
+		if locale == language.MustParse("nb") {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("missing locale nb")
+	}
+}
+
+func TestIsSupported(t *testing.T) {
+	tests := []struct {
+		localeStr string
+		supported bool
+	}{
+		{localeStr: "en", supported: true},
+		{localeStr: "en-US", supported: true},
+		{localeStr: "de-CH", supported: true},
+		{localeStr: "tlh", supported: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.localeStr, func(t *testing.T) {
+			if supported := compactnumber.IsSupported(language.MustParse(tt.localeStr)); supported != tt.supported {
+				t.Errorf("got unexpected support %v (wanted %v)", supported, tt.supported)
+			}
+		})
+	}
+}
+
+func TestRules(t *testing.T) {
+	rules, err := compactnumber.Rules(language.MustParse("en-US"), compactnumber.Short)
+	if err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+	if len(rules) != 11 {
+		t.Fatalf("got %d rules (wanted 11)", len(rules))
+	}
+
+	expected := compactnumber.Rule{
+		Type:            10000,
+		ZeroesInPattern: 2,
+		Patterns: map[compactnumber.PluralCategory]string{
+			compactnumber.PluralOne:   "00K",
+			compactnumber.PluralOther: "00K",
+		},
+	}
+	if !reflect.DeepEqual(rules[1], expected) {
+		t.Errorf("got unexpected rule %+v (wanted %+v)", rules[1], expected)
+	}
+	if divisor := rules[1].Divisor(); divisor != 1000 {
+		t.Errorf("got unexpected divisor %d (wanted 1000)", divisor)
+	}
+
+	categories := rules[1].PluralCategories()
+	if !reflect.DeepEqual(categories, []compactnumber.PluralCategory{compactnumber.PluralOne, compactnumber.PluralOther}) {
+		t.Errorf("got unexpected plural categories %v", categories)
+	}
+
+	rules, err = compactnumber.Rules(language.MustParse("en"), compactnumber.None)
+	if err != nil || len(rules) != 0 {
+		t.Errorf("got unexpected rules %v and error %v for None", rules, err)
+	}
+
+	_, err = compactnumber.Rules(language.MustParse("tlh"), compactnumber.Short)
+	if err == nil {
+		t.Error("got no error for unsupported locale")
+	}
+}
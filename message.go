@@ -0,0 +1,498 @@
+package compactnumber
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Message is a parsed ICU MessageFormat pattern, formatted with compact numbers. It supports a subset of the syntax:
+//
+//   - literal text, with apostrophes quoting braces and # as in ICU, e.g. '{', and a doubled apostrophe for one
+//   - simple arguments, {name}
+//   - number arguments, {name, number}, {name, number, integer}, and with the skeletons ParseSkeleton supports, e.g.
+//     {name, number, ::compact-short}
+//   - plural arguments with exact and category cases and #, e.g. {name, plural, =0 {none} one {# view} other {# views}}
+//   - select arguments, e.g. {name, select, female {her} male {his} other {their}}
+//
+// Plural arguments are compact-aware: if the message formats the same argument as a compact number, its plural
+// category, and #, are the ones of the number shown, e.g. "21 тыс." takes the "one" case in Russian. Exact cases still
+// match the argument itself, as in ICU.
+//
+// See https://unicode-org.github.io/icu/userguide/format_parse/messages/
+type Message struct {
+	lang      string
+	options   []FormatterOption
+	parts     []messagePart
+	skeletons map[string]string
+}
+
+type messagePartKind int
+
+const (
+	literalPart messagePartKind = iota
+	simplePart
+	numberPart
+	pluralPart
+	selectPart
+	poundPart
+)
+
+// A literal or argument of a message. Plural and select arguments choose one of their cases.
+type messagePart struct {
+	kind     messagePartKind
+	literal  string
+	arg      string
+	skeleton string
+	cases    []messageCase
+}
+
+type messageCase struct {
+	key   string
+	parts []messagePart
+}
+
+// ParseMessage parses a MessageFormat pattern for a locale. The formatter options apply to every number argument.
+func ParseMessage(lang string, pattern string, options ...FormatterOption) (Message, error) {
+	p := messageParser{pattern: []rune(pattern)}
+	parts, err := p.parseMessage(false)
+	if err != nil {
+		return Message{}, err
+	}
+	if p.pos < len(p.pattern) {
+		return Message{}, p.errorf("unmatched '}'")
+	}
+
+	m := Message{
+		lang:      lang,
+		options:   options,
+		parts:     parts,
+		skeletons: make(map[string]string),
+	}
+	m.collectSkeletons(parts)
+
+	return m, nil
+}
+
+// FormatMessage parses and formats a MessageFormat pattern in one step.
+func FormatMessage(lang string, pattern string, args map[string]interface{}, options ...FormatterOption) (string, error) {
+	m, err := ParseMessage(lang, pattern, options...)
+	if err != nil {
+		return "", err
+	}
+
+	return m.Format(args)
+}
+
+// Format formats the message with its arguments by name. Number and plural arguments must be integers.
+func (m Message) Format(args map[string]interface{}) (string, error) {
+	var b strings.Builder
+	if err := m.format(&b, m.parts, args, ""); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// Writes the parts of a message, replacing # with pound, the number of the innermost plural argument.
+func (m Message) format(b *strings.Builder, parts []messagePart, args map[string]interface{}, pound string) error {
+	for _, part := range parts {
+		if part.kind == literalPart {
+			b.WriteString(part.literal)
+			continue
+		}
+		if part.kind == poundPart {
+			b.WriteString(pound)
+			continue
+		}
+
+		value, ok := args[part.arg]
+		if !ok {
+			return errors.New(fmt.Sprintf("missing message argument %s", part.arg))
+		}
+
+		switch part.kind {
+		case simplePart:
+			n, ok, err := messageInt(part.arg, value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				result, err := m.formatNumber(n, "")
+				if err != nil {
+					return err
+				}
+				b.WriteString(result.Text)
+			} else {
+				b.WriteString(fmt.Sprint(value))
+			}
+		case numberPart:
+			n, ok, err := messageInt(part.arg, value)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errors.New(fmt.Sprintf("message argument %s is not an integer: %v", part.arg, value))
+			}
+
+			result, err := m.formatNumber(n, part.skeleton)
+			if err != nil {
+				return err
+			}
+			b.WriteString(result.Text)
+		case pluralPart:
+			n, ok, err := messageInt(part.arg, value)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errors.New(fmt.Sprintf("message argument %s is not an integer: %v", part.arg, value))
+			}
+
+			result, err := m.formatNumber(n, m.skeletons[part.arg])
+			if err != nil {
+				return err
+			}
+			if err := m.format(b, selectCase(part.cases, "="+strconv.Itoa(n), string(result.PluralCategory)), args, result.Text); err != nil {
+				return err
+			}
+		case selectPart:
+			if err := m.format(b, selectCase(part.cases, fmt.Sprint(value)), args, pound); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Formats a number with a formatter for the message's locale and options, configured by a skeleton if there is one.
+// The message's options come after those of the skeleton.
+func (m Message) formatNumber(n int, skeleton string) (Result, error) {
+	compactType, options := None, []FormatterOption(nil)
+	if skeleton != "" {
+		var err error
+		if compactType, options, err = skeletonOptions(skeleton); err != nil {
+			return Result{}, err
+		}
+	}
+
+	formatter := NewFormatter(m.lang, compactType, append(options, m.options...)...)
+	return formatter.FormatWithPlural(n)
+}
+
+// Records the skeleton of the first number argument of each argument name, which its plural arguments use.
+func (m Message) collectSkeletons(parts []messagePart) {
+	for _, part := range parts {
+		switch part.kind {
+		case numberPart:
+			if _, ok := m.skeletons[part.arg]; !ok {
+				m.skeletons[part.arg] = part.skeleton
+			}
+		case pluralPart, selectPart:
+			for _, c := range part.cases {
+				m.collectSkeletons(c.parts)
+			}
+		}
+	}
+}
+
+// Gets the parts of the first case matching one of the keys, or of the "other" case.
+func selectCase(cases []messageCase, keys ...string) []messagePart {
+	for _, key := range keys {
+		for _, c := range cases {
+			if c.key == key {
+				return c.parts
+			}
+		}
+	}
+	for _, c := range cases {
+		if c.key == "other" {
+			return c.parts
+		}
+	}
+
+	return nil
+}
+
+// Converts a message argument to an int if it is an integer. An error is returned for integers out of the range of
+// int, rather than letting them wrap around.
+func messageInt(name string, value interface{}) (int, bool, error) {
+	switch v := value.(type) {
+	case int:
+		return v, true, nil
+	case int8:
+		return int(v), true, nil
+	case int16:
+		return int(v), true, nil
+	case int32:
+		return int(v), true, nil
+	case int64:
+		if v < int64(minInt) || v > int64(maxInt) {
+			return 0, true, outOfIntRange(name, value)
+		}
+		return int(v), true, nil
+	case uint:
+		if uint64(v) > uint64(maxInt) {
+			return 0, true, outOfIntRange(name, value)
+		}
+		return int(v), true, nil
+	case uint8:
+		return int(v), true, nil
+	case uint16:
+		return int(v), true, nil
+	case uint32:
+		if uint64(v) > uint64(maxInt) {
+			return 0, true, outOfIntRange(name, value)
+		}
+		return int(v), true, nil
+	case uint64:
+		if v > uint64(maxInt) {
+			return 0, true, outOfIntRange(name, value)
+		}
+		return int(v), true, nil
+	default:
+		return 0, false, nil
+	}
+}
+
+// Gets the error for a message argument whose value is out of the range of int.
+func outOfIntRange(name string, value interface{}) error {
+	return errors.New(fmt.Sprintf("message argument %s is out of the range of int: %v", name, value))
+}
+
+type messageParser struct {
+	pattern []rune
+	pos     int
+}
+
+// Parses message text up to the end of the pattern or an unmatched '}', which is left for the caller.
+func (p *messageParser) parseMessage(inPlural bool) ([]messagePart, error) {
+	var parts []messagePart
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			parts = append(parts, messagePart{kind: literalPart, literal: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for p.pos < len(p.pattern) {
+		r := p.pattern[p.pos]
+		switch {
+		case r == '\'':
+			p.parseQuoted(&literal, inPlural)
+		case r == '{':
+			flush()
+			part, err := p.parseArgument(inPlural)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, part)
+		case r == '}':
+			flush()
+			return parts, nil
+		case r == '#' && inPlural:
+			flush()
+			parts = append(parts, messagePart{kind: poundPart})
+			p.pos++
+		default:
+			literal.WriteRune(r)
+			p.pos++
+		}
+	}
+
+	flush()
+	return parts, nil
+}
+
+// Parses an apostrophe: a doubled one is a literal apostrophe, one before a syntax character starts quoted text up to
+// the next single apostrophe, and any other is literal.
+func (p *messageParser) parseQuoted(literal *strings.Builder, inPlural bool) {
+	p.pos++
+	if p.pos < len(p.pattern) && p.pattern[p.pos] == '\'' {
+		literal.WriteRune('\'')
+		p.pos++
+		return
+	}
+	if p.pos == len(p.pattern) || !(p.pattern[p.pos] == '{' || p.pattern[p.pos] == '}' || p.pattern[p.pos] == '#' && inPlural) {
+		literal.WriteRune('\'')
+		return
+	}
+
+	for p.pos < len(p.pattern) {
+		r := p.pattern[p.pos]
+		p.pos++
+		if r != '\'' {
+			literal.WriteRune(r)
+		} else if p.pos < len(p.pattern) && p.pattern[p.pos] == '\'' {
+			literal.WriteRune('\'')
+			p.pos++
+		} else {
+			return
+		}
+	}
+}
+
+// Parses an argument, from its opening to its closing brace.
+func (p *messageParser) parseArgument(inPlural bool) (messagePart, error) {
+	p.pos++
+	name := p.parseWord()
+	if name == "" {
+		return messagePart{}, p.errorf("missing argument name")
+	}
+
+	if p.consume('}') {
+		return messagePart{kind: simplePart, arg: name}, nil
+	}
+	if !p.consume(',') {
+		return messagePart{}, p.errorf("expected ',' or '}' after argument %s", name)
+	}
+
+	argType := p.parseWord()
+	switch argType {
+	case "number":
+		skeleton := ""
+		if p.consume(',') {
+			style, err := p.parseStyle()
+			if err != nil {
+				return messagePart{}, err
+			}
+
+			switch {
+			case style == "integer":
+			case strings.HasPrefix(style, "::"):
+				skeleton = strings.TrimPrefix(style, "::")
+				if _, err := ParseSkeleton("", skeleton); err != nil {
+					return messagePart{}, p.errorf("%s", err)
+				}
+			default:
+				return messagePart{}, p.errorf("unsupported number style %q", style)
+			}
+		}
+		if !p.consume('}') {
+			return messagePart{}, p.errorf("expected '}' after number argument %s", name)
+		}
+
+		return messagePart{kind: numberPart, arg: name, skeleton: skeleton}, nil
+	case "plural", "select":
+		if !p.consume(',') {
+			return messagePart{}, p.errorf("expected ',' after %s argument %s", argType, name)
+		}
+
+		kind := selectPart
+		if argType == "plural" {
+			kind, inPlural = pluralPart, true
+		}
+		cases, err := p.parseCases(kind, inPlural)
+		if err != nil {
+			return messagePart{}, err
+		}
+
+		return messagePart{kind: kind, arg: name, cases: cases}, nil
+	default:
+		return messagePart{}, p.errorf("unsupported argument type %q", argType)
+	}
+}
+
+// Parses the cases of a plural or select argument, up to and including its closing brace.
+func (p *messageParser) parseCases(kind messagePartKind, inPlural bool) ([]messageCase, error) {
+	var cases []messageCase
+	hasOther := false
+	for !p.consume('}') {
+		key := p.parseWord()
+		switch {
+		case key == "":
+			return nil, p.errorf("expected case key or '}'")
+		case kind == pluralPart && !validPluralKey(key):
+			return nil, p.errorf("invalid plural case %q", key)
+		}
+		if !p.consume('{') {
+			return nil, p.errorf("expected '{' after case %s", key)
+		}
+
+		parts, err := p.parseMessage(inPlural)
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume('}') {
+			return nil, p.errorf("unterminated case %s", key)
+		}
+
+		hasOther = hasOther || key == "other"
+		cases = append(cases, messageCase{key: key, parts: parts})
+	}
+	if !hasOther {
+		return nil, p.errorf("missing \"other\" case")
+	}
+
+	return cases, nil
+}
+
+// Parses the style of a number argument, up to its closing brace.
+func (p *messageParser) parseStyle() (string, error) {
+	start := p.pos
+	for p.pos < len(p.pattern) && p.pattern[p.pos] != '}' {
+		if p.pattern[p.pos] == '{' || p.pattern[p.pos] == '\'' {
+			return "", p.errorf("unsupported number style")
+		}
+		p.pos++
+	}
+
+	return strings.TrimSpace(string(p.pattern[start:p.pos])), nil
+}
+
+// Parses a name, type or case key, skipping the whitespace around it.
+func (p *messageParser) parseWord() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.pattern) {
+		r := p.pattern[p.pos]
+		if unicode.IsSpace(r) || strings.ContainsRune("{},'#", r) {
+			break
+		}
+		p.pos++
+	}
+	word := string(p.pattern[start:p.pos])
+	p.skipSpace()
+
+	return word
+}
+
+// Consumes r if it is next, after whitespace.
+func (p *messageParser) consume(r rune) bool {
+	p.skipSpace()
+	if p.pos < len(p.pattern) && p.pattern[p.pos] == r {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+func (p *messageParser) skipSpace() {
+	for p.pos < len(p.pattern) && unicode.IsSpace(p.pattern[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *messageParser) errorf(format string, args ...interface{}) error {
+	return errors.New(fmt.Sprintf("invalid message at offset %d: %s", p.pos, fmt.Sprintf(format, args...)))
+}
+
+// Reports whether a plural case key is an exact number or a plural category.
+func validPluralKey(key string) bool {
+	if strings.HasPrefix(key, "=") {
+		_, err := strconv.Atoi(key[1:])
+		return err == nil
+	}
+	for _, category := range pluralCategoryOrder {
+		if key == string(category) {
+			return true
+		}
+	}
+
+	return false
+}
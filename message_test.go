@@ -0,0 +1,144 @@
+package compactnumber_test
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+)
+
+func TestFormatMessage(t *testing.T) {
+	tests := []struct {
+		localeStr string
+		pattern   string
+		args      map[string]interface{}
+		expected  string
+	}{
+		{
+			localeStr: "en-US",
+			pattern:   "{count, number, ::compact-short} {count, plural, one {view} other {views}}",
+			args:      map[string]interface{}{"count": 12345},
+			expected:  "12K views",
+		},
+		{
+			localeStr: "en-US",
+			pattern:   "{count, number, ::compact-long} {count, plural, one {view} other {views}}",
+			args:      map[string]interface{}{"count": int64(2000000)},
+			expected:  "2 million views",
+		},
+		{
+			localeStr: "ru",
+			pattern:   "{count, number, ::compact-short} {count, plural, one {просмотр} few {просмотра} other {просмотров}}",
+			args:      map[string]interface{}{"count": 21000},
+			expected:  "21 тыс. просмотр",
+		},
+		{
+			localeStr: "en-US",
+			pattern:   "{count, number, ::K rounding-mode-half-up} {count, plural, one {view} other {views}}",
+			args:      map[string]interface{}{"count": 2450},
+			expected:  "2.5K views",
+		},
+		{
+			localeStr: "ru",
+			pattern:   "{count, plural, one {# просмотр} few {# просмотра} other {# просмотров}}",
+			args:      map[string]interface{}{"count": 21000},
+			expected:  "21 000 просмотров",
+		},
+		{
+			localeStr: "en-US",
+			pattern:   "{count, plural, =0 {no views} =1 {a single view} other {# views}}",
+			args:      map[string]interface{}{"count": 1},
+			expected:  "a single view",
+		},
+		{
+			localeStr: "en-US",
+			pattern:   "{count, plural, =0 {no views} =1 {a single view} other {# views}}",
+			args:      map[string]interface{}{"count": 12345},
+			expected:  "12,345 views",
+		},
+		{
+			localeStr: "en-US",
+			pattern:   "{name} liked {gender, select, female {her} male {his} other {their}} {count, number} posts",
+			args:      map[string]interface{}{"name": "Kim", "gender": "nonbinary", "count": 1200},
+			expected:  "Kim liked their 1,200 posts",
+		},
+		{
+			localeStr: "en-US",
+			pattern:   "{gender, select, female {{count, plural, one {her # post} other {her # posts}}} other {{count} posts}}",
+			args:      map[string]interface{}{"gender": "female", "count": 1},
+			expected:  "her 1 post",
+		},
+		{
+			localeStr: "en-US",
+			pattern:   "it''s '{'literal'}' and '#' {count, plural, other {'#' #}}",
+			args:      map[string]interface{}{"count": 5},
+			expected:  "it's {literal} and '#' # 5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s", tt.localeStr, tt.pattern), func(t *testing.T) {
+			out, err := compactnumber.FormatMessage(tt.localeStr, tt.pattern, tt.args)
+			mustMatch(t, out, err, tt.expected, nil)
+		})
+	}
+}
+
+func TestFormatMessageOptions(t *testing.T) {
+	out, err := compactnumber.FormatMessage("en-US", "{count, number, ::compact-short} views", map[string]interface{}{"count": 150000}, compactnumber.Cap(99999))
+	mustMatch(t, out, err, "99K+ views", nil)
+
+	// The message's options come after those of the skeleton
+	out, err = compactnumber.FormatMessage("en-US", "{count, number, ::compact-short}", map[string]interface{}{"count": 1290}, compactnumber.Round(compactnumber.RoundDown))
+	mustMatch(t, out, err, "1.2K", nil)
+	out, err = compactnumber.FormatMessage("en-US", "{count, number, ::compact-short}", map[string]interface{}{"count": 1290})
+	mustMatch(t, out, err, "1.3K", nil)
+}
+
+func TestParseMessageErrors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		err     string
+	}{
+		{"{count", "invalid message at offset 6: expected ',' or '}' after argument count"},
+		{"a } b", "invalid message at offset 2: unmatched '}'"},
+		{"{count, date}", "invalid message at offset 12: unsupported argument type \"date\""},
+		{"{count, number, currency}", "invalid message at offset 24: unsupported number style \"currency\""},
+		{"{count, number, ::scientific}", "invalid message at offset 28: unsupported skeleton stem \"scientific\""},
+		{"{count, plural, one {x}}", "invalid message at offset 24: missing \"other\" case"},
+		{"{count, plural, some {x} other {y}}", "invalid message at offset 21: invalid plural case \"some\""},
+		{"{count, select, a {x} other {y}", "invalid message at offset 31: expected case key or '}'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			_, err := compactnumber.ParseMessage("en-US", tt.pattern)
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("got unexpected error %v (wanted %s)", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestMessageFormatErrors(t *testing.T) {
+	m, err := compactnumber.ParseMessage("en-US", "{count, number, ::compact-short}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.Format(map[string]interface{}{}); err == nil {
+		t.Error("expected an error for a missing argument")
+	}
+	if _, err := m.Format(map[string]interface{}{"count": 1.5}); err == nil {
+		t.Error("expected an error for a non-integer number argument")
+	}
+
+	// Integers out of the range of int are not wrapped around
+	for _, count := range []interface{}{uint64(math.MaxUint64), uint64(math.MaxInt64) + 1} {
+		if _, err := m.Format(map[string]interface{}{"count": count}); err == nil || !strings.Contains(err.Error(), "out of the range of int") {
+			t.Errorf("got unexpected error %v for %v", err, count)
+		}
+	}
+	out, err := m.Format(map[string]interface{}{"count": uint64(math.MaxInt64)})
+	mustMatch(t, out, err, "9,223,372T", nil)
+}
@@ -1,5 +1,7 @@
 package compactnumber
 
+import "github.com/nkall/compactnumber/internal/models"
+
 // CompactType is an enum used to specify compaction settings.
 type CompactType string
 
@@ -11,3 +13,132 @@ const (
 	// Short-form compaction, e.g. 17M.
 	Short = CompactType("Short")
 )
+
+// SignDisplay is an enum used to specify when the sign of a number is shown, mirroring ICU's sign display modes.
+type SignDisplay string
+
+const (
+	// Show the minus sign on negative numbers only, e.g. -5K and 5K.
+	SignAuto = SignDisplay("auto")
+	// Show the plus sign on positive numbers and zero too, e.g. +5K and +0.
+	SignAlways = SignDisplay("always")
+	// Never show a sign, e.g. 5K for -5000.
+	SignNever = SignDisplay("never")
+	// Show the plus sign on positive numbers, but no sign on zero, e.g. +5K and 0.
+	SignExceptZero = SignDisplay("except-zero")
+	// Show negative numbers in the locale's accounting format, e.g. (5K) in English.
+	SignAccounting = SignDisplay("accounting")
+	// Show negative numbers in the locale's accounting format, and the plus sign on positive numbers and zero.
+	SignAccountingAlways = SignDisplay("accounting-always")
+)
+
+// Applies the sign display to a number, returning the number to format and the affixes to wrap it in.
+func (d SignDisplay) apply(num compactNum, signs models.Signs) (compactNum, string, string) {
+	accounting := d == SignAccounting || d == SignAccountingAlways
+	switch {
+	case num.negativeModifier < 0 && d == SignNever:
+		return num.abs(), "", ""
+	case num.negativeModifier < 0 && accounting && (signs.AccountingPrefix != "" || signs.AccountingSuffix != ""):
+		return num.abs(), signs.AccountingPrefix, signs.AccountingSuffix
+	case num.negativeModifier > 0 && (d == SignAlways || d == SignAccountingAlways || (d == SignExceptZero && num.n != 0)):
+		num.plusSign = signs.PlusSign
+	}
+
+	return num, "", ""
+}
+
+// RoundingMode is an enum used to specify how compacted numbers are rounded, mirroring ICU's rounding modes.
+type RoundingMode string
+
+const (
+	// Round towards zero, e.g. 1K for 1999 in English. This is the default.
+	RoundDown = RoundingMode("down")
+	// Round away from zero.
+	RoundUp = RoundingMode("up")
+	// Round towards positive infinity.
+	RoundCeiling = RoundingMode("ceiling")
+	// Round towards negative infinity.
+	RoundFloor = RoundingMode("floor")
+	// Round to the nearest number, and halfway numbers to the even one.
+	RoundHalfEven = RoundingMode("half-even")
+	// Round to the nearest number, and halfway numbers towards zero.
+	RoundHalfDown = RoundingMode("half-down")
+	// Round to the nearest number, and halfway numbers away from zero.
+	RoundHalfUp = RoundingMode("half-up")
+)
+
+// Divides the absolute value of a number, n, by a divisor, rounding the quotient with the rounding mode. Negative
+// reports whether the number is negative, for the modes that round towards an infinity.
+func (m RoundingMode) divide(n int64, divisor int64, negative bool) int64 {
+	q, r := n/divisor, n%divisor
+	if r == 0 {
+		return q
+	}
+
+	up := false
+	switch m {
+	case RoundUp:
+		up = true
+	case RoundCeiling:
+		up = !negative
+	case RoundFloor:
+		up = negative
+	case RoundHalfEven, RoundHalfDown, RoundHalfUp:
+		switch {
+		case 2*r > divisor:
+			up = true
+		case 2*r == divisor:
+			up = m == RoundHalfUp || m == RoundHalfEven && q%2 == 1
+		}
+	}
+
+	if up {
+		return q + 1
+	}
+	return q
+}
+
+// PrecisionMode is an enum used to specify how many digits compacted numbers show.
+type PrecisionMode string
+
+const (
+	// Show the integer part of compacted numbers, e.g. 1K for 1234 in English. This is the default.
+	PrecisionInteger = PrecisionMode("integer")
+	// Show two significant digits of compacted numbers below 100, and the integer part of others, like ICU and
+	// browsers do by default, e.g. 1.2K for 1234 and 123K for 123456 in English.
+	PrecisionCompact = PrecisionMode("compact")
+)
+
+// FallbackPolicy is an enum used to specify what Format does for locales without compact forms and for plural
+// categories without a pattern.
+type FallbackPolicy string
+
+const (
+	// Return a LocaleError for locales without compact forms, and format numbers whose plural category has no pattern
+	// with the "other" pattern. This is the default.
+	FallbackOther = FallbackPolicy("other")
+	// Return a LocaleError for locales without compact forms, and a PluralError for numbers whose plural category has no
+	// pattern.
+	FallbackStrict = FallbackPolicy("strict")
+	// Format numbers of locales without compact forms in the default locale, see DefaultLocale.
+	FallbackLocale = FallbackPolicy("locale")
+	// Format numbers of locales without compact forms in full, as with None.
+	FallbackNone = FallbackPolicy("none")
+)
+
+// FallbackReason is an enum used to specify why a FallbackEvent happened.
+type FallbackReason string
+
+const (
+	// The locale has no compact forms, so the default locale's were used, see FallbackLocale.
+	ReasonDefaultLocale = FallbackReason("default-locale")
+	// The locale has no compact forms, so the number was formatted in full, see FallbackNone.
+	ReasonNoCompactForms = FallbackReason("no-compact-forms")
+	// The locale has no plural rules, so every number has the "other" plural category.
+	ReasonNoPluralRules = FallbackReason("no-plural-rules")
+	// The rule of the number's type has no pattern for its plural category, so the "other" pattern was used.
+	ReasonPluralOther = FallbackReason("plural-other")
+	// The rule of the number's type has the "0" pattern, so the number was formatted in full, e.g. thousands in
+	// Japanese.
+	ReasonUncompacted = FallbackReason("uncompacted")
+)
@@ -0,0 +1,236 @@
+package compactnumber
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/nkall/compactnumber/internal/compact"
+	"github.com/nkall/compactnumber/internal/models"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// numberOptions are the number options of compacted numbers and of numbers formatted in full, and whether they set
+// their precision, in which case they round the numbers instead of the formatter.
+type numberOptions struct {
+	compact          []number.Option
+	full             []number.Option
+	compactPrecision bool
+	fullPrecision    bool
+}
+
+// Resolves the number options of a call: the formatter's options for compacted numbers or for numbers formatted in
+// full, then the call's, which apply to both. Integer reports whether numbers are integers unless the options set
+// their precision, as in Format, rather than as precise as the options allow, as in FormatAt. Options setting the
+// precision start from the defaults of golang.org/x/text, so number.Precision(2) is not limited to integers.
+//
+// An error is returned if options setting the precision conflict with the formatter's: compacted numbers are rounded
+// by the formatter if it has a precision or a rounding mode, and numbers formatted in full if it has a precision.
+func (f *Formatter) numberOptions(numOptions []number.Option, integer bool) (numberOptions, error) {
+	options := numberOptions{
+		compact: append(f.compactNumOptions[:len(f.compactNumOptions):len(f.compactNumOptions)], numOptions...),
+		full:    append(f.fullNumOptions[:len(f.fullNumOptions):len(f.fullNumOptions)], numOptions...),
+	}
+	if !integer {
+		return options, nil
+	}
+
+	if options.compactPrecision = setsPrecision(options.compact); !options.compactPrecision {
+		options.compact = append([]number.Option{number.Scale(0)}, options.compact...)
+	}
+	if options.fullPrecision = setsPrecision(options.full); !options.fullPrecision {
+		options.full = append([]number.Option{number.Scale(0)}, options.full...)
+	}
+
+	switch {
+	case options.compactPrecision && f.precise:
+		return numberOptions{}, errors.New("number options setting the precision of compacted numbers conflict with the formatter's precision")
+	case options.compactPrecision && f.roundingMode != "":
+		return numberOptions{}, errors.New("number options setting the precision of compacted numbers conflict with the formatter's rounding mode")
+	case options.fullPrecision && f.precise:
+		return numberOptions{}, errors.New("number options setting the precision of numbers formatted in full conflict with the formatter's precision")
+	}
+
+	return options, nil
+}
+
+// precisionProbes are numbers formatted differently by number options setting their precision than by
+// number.Scale(0): a fraction, an integer rounded to one significant digit or to an increment, and an integer with as
+// many significant digits as a float64 holds.
+var precisionProbes = []float64{0.123456789, 10.7, 1111111111111111}
+
+// Reports whether number options set the precision of numbers, e.g. number.MaxFractionDigits or
+// number.IncrementString, overriding number.Scale(0). Options setting the integer digits, such as
+// number.MaxIntegerDigits, don't round numbers, so they don't set their precision.
+//
+// golang.org/x/text doesn't expose the settings of number options, so probe numbers are formatted with them. Integer
+// digits options only drop leading digits, so their output is a suffix of the one of number.Scale(0).
+func setsPrecision(numOptions []number.Option) bool {
+	scale := []number.Option{number.Scale(0)}
+	for _, probe := range precisionProbes {
+		scaled := roundWithOptions(probe, scale)
+		if out := roundWithOptions(probe, append(scale, numOptions...)); !strings.HasSuffix(scaled, out) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Gets the maximum number of significant digits number options round numbers to, or 0 if they don't. As for
+// setsPrecision, it is inferred from the output of probe numbers with more digits than the options show, whose
+// integer part ends with zeroes if the options round them, e.g. "1100000000000000" with number.Precision(2). Options
+// rounding to significant digits keep as many digits of probes of different magnitudes, unlike increments.
+func maxSignificantDigits(numOptions []number.Option) int {
+	significantDigits := 0
+	for i, probe := range []float64{1111111111111111, 111111111111111.1} {
+		integer := strings.SplitN(roundWithOptions(probe, numOptions), ".", 2)[0]
+		significant := strings.TrimRight(integer, "0")
+		if len(significant) == len(integer) || i > 0 && len(significant) != significantDigits {
+			return 0
+		}
+		significantDigits = len(significant)
+	}
+
+	return significantDigits
+}
+
+// Rounds x, a non-negative number, as golang.org/x/text formats it with the number options, returning the rounded
+// number in decimal notation, with its fraction zeroes, e.g. "1.20" for 1.2 with number.MinFractionDigits(2).
+func rounded(x float64, numOptions []number.Option) (string, float64, error) {
+	decimal := roundWithOptions(x, numOptions)
+	value, err := strconv.ParseFloat(decimal, 64)
+	if err != nil {
+		return "", 0, errors.New(fmt.Sprintf("number options format %v as %q, which is not a number", x, decimal))
+	}
+
+	// golang.org/x/text drops the carry of numbers rounded up to the next power of ten by significant digits, e.g. 999
+	// is "100" with number.Precision(1). Rounding never goes below the number truncated to its significant digits, so
+	// a smaller result is the next power of ten, formatted again to show it with the digits of the options.
+	if significantDigits := maxSignificantDigits(numOptions); significantDigits > 0 && x > 0 {
+		power := powerOfTen(x)
+		unit := power / math.Pow(10, float64(significantDigits-1))
+		if math.Round(value/unit) < math.Floor(x/unit) {
+			value = power * 10
+			decimal = roundWithOptions(value, numOptions)
+		}
+	}
+
+	return decimal, value, nil
+}
+
+// Rounds x, a non-negative number, toward zero at the precision golang.org/x/text formats it with under the number
+// options, e.g. 99.999 to "99" with number.MaxFractionDigits(0), where rounded gives "100".
+func truncated(x float64, numOptions []number.Option) (string, float64, error) {
+	decimal, value, err := rounded(x, numOptions)
+	if err != nil || value <= x {
+		return decimal, value, err
+	}
+
+	// The unit of the last digit the options show at the magnitude of x, e.g. 0.1 for 11.1 with
+	// number.MaxFractionDigits(1) or 100 for 1100 with number.Precision(2)
+	probe := roundWithOptions(powerOfTen(x)*1.111111111111, numOptions)
+	unit := 1.0
+	if point := strings.IndexByte(probe, '.'); point >= 0 {
+		unit = math.Pow(10, -float64(len(probe)-point-1))
+	} else {
+		for i := len(probe) - 1; i > 0 && probe[i] == '0'; i-- {
+			unit *= 10
+		}
+	}
+
+	// Increments may round the truncated number up again, so it is lowered until it is not
+	for t := math.Floor(x/unit+1e-9) * unit; value > x && t >= 0; t -= unit {
+		if decimal, value, err = rounded(t, numOptions); err != nil {
+			return "", 0, err
+		}
+	}
+
+	return decimal, value, nil
+}
+
+// Gets the greatest power of ten less than or equal to x, a positive number.
+func powerOfTen(x float64) float64 {
+	power := 1.0
+	for power*10 <= x {
+		power *= 10
+	}
+	for power > x {
+		power /= 10
+	}
+
+	return power
+}
+
+// Formats x, a non-negative number, with the number options in the root locale, which has ASCII digits and a decimal
+// point, keeping only the digits and the point of the output.
+func roundWithOptions(x float64, numOptions []number.Option) string {
+	numOptions = append(numOptions[:len(numOptions):len(numOptions)], number.NoSeparator())
+	out := message.NewPrinter(language.Und).Sprint(number.Decimal(x, numOptions...))
+
+	decimal := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' || r == '.' {
+			return r
+		}
+		return -1
+	}, out)
+
+	// Leading zeroes come from padding or minimum integer digits
+	decimal = strings.TrimLeft(decimal, "0")
+	if decimal == "" || decimal[0] == '.' {
+		decimal = "0" + decimal
+	}
+
+	return decimal
+}
+
+// Prepares n, an absolute value, for formatting with one of the compact form rules, rounded by the number options
+// rather than by the formatter.
+func (f *Formatter) optionsCompactNum(compactForm []models.CompactFormRule, n int, negativeModifier int, noSeparator bool, options numberOptions) (compactNum, error) {
+	rule := compact.SelectRule(compactForm, int64(n))
+
+	divisor, numOptions := int64(1), options.full
+	if compacts(rule) {
+		divisor, numOptions = compact.Divisor(rule), options.compact
+	}
+
+	// The formatter only has a rounding mode here if it formats the number in the at-least pattern, which truncates it
+	round := rounded
+	if f.roundingMode == RoundDown {
+		round = truncated
+	}
+	decimal, shortN, err := round(float64(n)/float64(divisor), numOptions)
+	if err != nil {
+		return compactNum{}, err
+	}
+
+	// Rounding up may reach the type of the next rule, e.g. 999999 to 1000K, which is formatted with that rule instead
+	roundedN := int(math.Round(shortN * float64(divisor)))
+	if next := compact.SelectRule(compactForm, int64(roundedN)); next.Type > rule.Type {
+		return f.compactNum(compactForm, roundedN*negativeModifier, options)
+	}
+
+	// The plural form depends on the fraction digits shown, e.g. "1.20" is plural in English
+	plurForm := f.pluralForm(decimal)
+
+	pattern, err := f.pattern(rule, plurForm)
+	if err != nil {
+		return compactNum{}, err
+	}
+
+	return compactNum{
+		n:                roundedN * negativeModifier,
+		negativeModifier: negativeModifier,
+		shortN:           shortN * float64(negativeModifier),
+		rule:             rule,
+		pluralForm:       plurForm,
+		pattern:          pattern,
+		noSeparator:      noSeparator || f.minimumGroupingDigits > 0 && digits(int(shortN)) < primaryGroupingSize+f.minimumGroupingDigits,
+		divisor:          divisor,
+		decimal:          decimal,
+	}, nil
+}
@@ -0,0 +1,164 @@
+package compactnumber_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+	"golang.org/x/text/number"
+)
+
+func TestFormatterNumberOptions(t *testing.T) {
+	tests := []struct {
+		n           int
+		numOptions  []number.Option
+		expectedOut string
+	}{
+		{n: 1234, numOptions: []number.Option{number.MaxFractionDigits(1)}, expectedOut: "1.2K"},
+		{n: -1250, numOptions: []number.Option{number.MaxFractionDigits(1)}, expectedOut: "-1.2K"},
+		{n: 1234, numOptions: []number.Option{number.Precision(2)}, expectedOut: "1.2K"},
+		{n: 1234, numOptions: []number.Option{number.Precision(5)}, expectedOut: "1.234K"},
+		{n: 12345, numOptions: []number.Option{number.Precision(5)}, expectedOut: "12.345K"},
+		{n: 1300, numOptions: []number.Option{number.IncrementString("0.5")}, expectedOut: "1.5K"},
+		{n: 1000, numOptions: []number.Option{number.MinFractionDigits(1)}, expectedOut: "1.0K"},
+		{n: 999949, numOptions: []number.Option{number.MaxFractionDigits(1)}, expectedOut: "999.9K"},
+		// Rounding up to the next type uses its rule
+		{n: 999999, numOptions: []number.Option{number.MaxFractionDigits(2)}, expectedOut: "1M"},
+		{n: 999, numOptions: []number.Option{number.Precision(1)}, expectedOut: "1K"},
+		// Numbers formatted in full are rounded too
+		{n: 987, numOptions: []number.Option{number.Precision(2)}, expectedOut: "990"},
+		{n: 987, numOptions: []number.Option{number.IncrementString("10")}, expectedOut: "990"},
+		// Options that don't set the precision leave it to the formatter
+		{n: 1999, numOptions: []number.Option{number.NoSeparator()}, expectedOut: "1K"},
+		{n: 1999, numOptions: []number.Option{number.MinIntegerDigits(2)}, expectedOut: "01K"},
+		{n: 1234, numOptions: []number.Option{number.MaxIntegerDigits(2)}, expectedOut: "1K"},
+		{n: 123456789, numOptions: []number.Option{number.MaxIntegerDigits(2)}, expectedOut: "23M"},
+		{n: 999, numOptions: []number.Option{number.MaxIntegerDigits(2)}, expectedOut: "99"},
+		{n: 1999, numOptions: []number.Option{number.MaxFractionDigits(0)}, expectedOut: "1K"},
+		{n: 1999, numOptions: []number.Option{number.FormatWidth(4)}, expectedOut: "   1K"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d/%s", tt.n, tt.expectedOut), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter("en-US", compactnumber.Short)
+			out, err := formatter.Format(tt.n, tt.numOptions...)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+}
+
+func TestFormatterNumberOptionsCarry(t *testing.T) {
+	// Rounding mantissas of 9.99, 99.9 and 999 to fewer significant digits carries over to the next power of ten
+	tests := []struct {
+		n           int
+		precision   int
+		expectedOut string
+	}{
+		{n: 9990, precision: 1, expectedOut: "10K"},
+		{n: 9990, precision: 2, expectedOut: "10K"},
+		{n: 9990, precision: 3, expectedOut: "9.99K"},
+		{n: 99900, precision: 1, expectedOut: "100K"},
+		{n: 99900, precision: 2, expectedOut: "100K"},
+		{n: 99900, precision: 3, expectedOut: "99.9K"},
+		{n: 999000, precision: 1, expectedOut: "1M"},
+		{n: 999000, precision: 2, expectedOut: "1M"},
+		{n: 999000, precision: 3, expectedOut: "999K"},
+		{n: 99, precision: 1, expectedOut: "100"},
+		// Numbers that don't round up to a power of ten keep their digits
+		{n: 9949, precision: 2, expectedOut: "9.9K"},
+		{n: 1040, precision: 1, expectedOut: "1K"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d/%d", tt.n, tt.precision), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter("en-US", compactnumber.Short)
+			out, err := formatter.Format(tt.n, number.Precision(tt.precision))
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+
+	// The carried number is shown with the digits of the options
+	formatter := compactnumber.NewFormatter("en-US", compactnumber.Short)
+	out, err := formatter.Format(9990, number.Precision(2), number.MinFractionDigits(1))
+	mustMatch(t, out, err, "10.0K", nil)
+}
+
+func TestFormatterNumberOptionsPlural(t *testing.T) {
+	// The plural category is the one of the number shown, with its fraction digits: "1.0" is plural in English
+	formatter := compactnumber.NewFormatter("en-US", compactnumber.Long)
+	result, err := formatter.FormatWithPlural(1000, number.MinFractionDigits(1))
+	mustMatch(t, result.Text, err, "1.0 thousand", nil)
+	if result.PluralCategory != compactnumber.PluralOther {
+		t.Errorf("got unexpected plural category %s (wanted %s)", result.PluralCategory, compactnumber.PluralOther)
+	}
+}
+
+func TestFormatterNumberOptionsConflicts(t *testing.T) {
+	tests := []struct {
+		name       string
+		options    []compactnumber.FormatterOption
+		numOptions []number.Option
+		err        string
+	}{
+		{
+			name:       "significant digits",
+			options:    []compactnumber.FormatterOption{compactnumber.SignificantDigits(2)},
+			numOptions: []number.Option{number.MaxFractionDigits(1)},
+			err:        "number options setting the precision of compacted numbers conflict with the formatter's precision",
+		},
+		{
+			name:       "rounding mode",
+			options:    []compactnumber.FormatterOption{compactnumber.Round(compactnumber.RoundUp)},
+			numOptions: []number.Option{number.IncrementString("0.5")},
+			err:        "number options setting the precision of compacted numbers conflict with the formatter's rounding mode",
+		},
+		{
+			name:    "full number options",
+			options: []compactnumber.FormatterOption{compactnumber.Precision(compactnumber.PrecisionCompact), compactnumber.FullNumberOptions(number.Precision(2))},
+			err:     "number options setting the precision of numbers formatted in full conflict with the formatter's precision",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			formatter := compactnumber.NewFormatter("en-US", compactnumber.Short, tt.options...)
+			_, err := formatter.Format(1234, tt.numOptions...)
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("got unexpected error %v (wanted %s)", err, tt.err)
+			}
+		})
+	}
+
+	// Options that don't set the precision don't conflict
+	formatter := compactnumber.NewFormatter("en-US", compactnumber.Short, compactnumber.Round(compactnumber.RoundUp))
+	out, err := formatter.Format(1001, number.NoSeparator())
+	mustMatch(t, out, err, "2K", nil)
+}
+
+func TestCompactAndFullNumberOptions(t *testing.T) {
+	formatter := compactnumber.NewFormatter("en-US", compactnumber.Short,
+		compactnumber.CompactNumberOptions(number.MaxFractionDigits(1)),
+		compactnumber.FullNumberOptions(number.Precision(2)),
+	)
+
+	tests := []struct {
+		n           int
+		expectedOut string
+	}{
+		{n: 1234, expectedOut: "1.2K"},
+		{n: 987, expectedOut: "990"},
+		{n: 99, expectedOut: "99"},
+		// Rounding up to the next type uses its rule and its options
+		{n: 999, expectedOut: "1K"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.n), func(t *testing.T) {
+			out, err := formatter.Format(tt.n)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+
+	out, err := formatter.FormatRange(1234, 5678)
+	mustMatch(t, out, err, "1.2K–5.7K", nil)
+
+	// The options of each call apply after the formatter's
+	out, err = formatter.Format(1234, number.MaxFractionDigits(2))
+	mustMatch(t, out, err, "1.23K", nil)
+}
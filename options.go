@@ -0,0 +1,133 @@
+package compactnumber
+
+import (
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+// FormatterOption configures a Formatter created by NewFormatter.
+type FormatterOption func(*Formatter)
+
+// Approximately formats numbers with the locale's approximately pattern, e.g. "~1K" in English.
+func Approximately() FormatterOption {
+	return func(f *Formatter) {
+		f.approximately = true
+	}
+}
+
+// AtLeast formats numbers with the locale's at-least pattern, e.g. "1K+" in English. It takes precedence over
+// Approximately.
+func AtLeast() FormatterOption {
+	return func(f *Formatter) {
+		f.atLeast = true
+	}
+}
+
+// Cap formats numbers greater than max as max with the locale's at-least pattern, e.g. "99K+" in English for a max of
+// 99999. Numbers up to max are formatted as usual.
+func Cap(max int) FormatterOption {
+	return func(f *Formatter) {
+		f.capped = true
+		f.max = max
+	}
+}
+
+// DisplaySign sets when Format shows the sign of numbers. The default is SignAuto.
+func DisplaySign(display SignDisplay) FormatterOption {
+	return func(f *Formatter) {
+		f.signDisplay = display
+	}
+}
+
+// Threshold formats numbers whose absolute value is below min in full, e.g. "9,999" in English for a min of 10000.
+// Numbers with fewer digits than the locale's minimum grouping digits call for are not grouped, e.g. "9999" in Spanish.
+func Threshold(min int) FormatterOption {
+	return func(f *Formatter) {
+		f.threshold = min
+	}
+}
+
+// Round sets how Format rounds compacted numbers, e.g. "2K" in English for 1500 with RoundHalfUp. The default is
+// RoundDown, which truncates them.
+func Round(mode RoundingMode) FormatterOption {
+	return func(f *Formatter) {
+		f.roundingMode = mode
+	}
+}
+
+// Fallback sets what Format does for locales without compact forms and for plural categories without a pattern. The
+// default is FallbackOther.
+func Fallback(policy FallbackPolicy) FormatterOption {
+	return func(f *Formatter) {
+		f.fallback = policy
+	}
+}
+
+// DefaultLocale formats numbers of locales without compact forms in lang, e.g. "en" for "1K" in place of "tlh". It
+// sets the fallback policy to FallbackLocale, whose default locale is otherwise English.
+func DefaultLocale(lang string) FormatterOption {
+	return func(f *Formatter) {
+		f.fallback = FallbackLocale
+		f.defaultLocale = language.Make(lang)
+	}
+}
+
+// OnFallback calls fn for every number formatted with other data than its locale calls for: the compact forms of the
+// default locale or none, the "other" pattern or plural category in place of the number's, or a "0" pattern that
+// formats it in full. Locales falling back to their base language, e.g. "de-DE" to "de", are not reported, as that is
+// how CLDR data is inherited. The callback runs synchronously, so it should be cheap, e.g. incrementing a counter.
+func OnFallback(fn func(FallbackEvent)) FormatterOption {
+	return func(f *Formatter) {
+		f.onFallback = fn
+	}
+}
+
+// SignificantDigits rounds numbers to at most max significant digits with the formatter's rounding mode, e.g. "1.2K"
+// in English for 1234 and a max of 2. Numbers formatted in full are rounded too, e.g. "1,200" in Japanese, which
+// doesn't compact thousands. Trailing fraction zeroes are not shown. It takes precedence over FractionDigits.
+func SignificantDigits(max int) FormatterOption {
+	return func(f *Formatter) {
+		f.precise = true
+		f.compactRounding = false
+		f.maxSignificantDigits = max
+	}
+}
+
+// FractionDigits rounds compacted numbers to at most max fraction digits with the formatter's rounding mode, e.g.
+// "1.23K" in English for 1234 and a max of 2. Trailing fraction zeroes are not shown.
+func FractionDigits(max int) FormatterOption {
+	return func(f *Formatter) {
+		f.precise = true
+		f.compactRounding = false
+		f.maxFractionDigits = max
+	}
+}
+
+// Precision sets how many digits compacted numbers show, e.g. "1.2K" in English for 1234 with PrecisionCompact. The
+// default is DefaultPrecision. Unless Round sets the rounding mode, PrecisionCompact rounds half to even, as ICU does.
+// It replaces the digits set by SignificantDigits and FractionDigits, and the other way around.
+func Precision(mode PrecisionMode) FormatterOption {
+	return func(f *Formatter) {
+		f.precise = mode == PrecisionCompact
+		f.compactRounding = mode == PrecisionCompact
+		f.maxSignificantDigits = 0
+		f.maxFractionDigits = 0
+	}
+}
+
+// CompactNumberOptions sets the number options of compacted numbers, e.g. number.MaxFractionDigits(1) for "1.2K" in
+// English for 1234, and FullNumberOptions those of numbers formatted in full. The number options of each call apply
+// after them.
+func CompactNumberOptions(options ...number.Option) FormatterOption {
+	return func(f *Formatter) {
+		f.compactNumOptions = options
+	}
+}
+
+// FullNumberOptions sets the number options of numbers formatted in full, e.g. number.Precision(2) for "990" in English
+// for 987, see CompactNumberOptions.
+func FullNumberOptions(options ...number.Option) FormatterOption {
+	return func(f *Formatter) {
+		f.fullNumOptions = options
+	}
+}
@@ -0,0 +1,268 @@
+package compactnumber_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+	"golang.org/x/text/number"
+)
+
+func TestFormatterOptions(t *testing.T) {
+	tests := []struct {
+		localeStr   string
+		options     []compactnumber.FormatterOption
+		n           int
+		expectedOut string
+	}{
+		{localeStr: "en-US", options: []compactnumber.FormatterOption{compactnumber.Approximately()}, n: 1234000, expectedOut: "~1M"},
+		{localeStr: "ru", options: []compactnumber.FormatterOption{compactnumber.Approximately()}, n: 1234000, expectedOut: "≈1 млн"},
+		{localeStr: "ja", options: []compactnumber.FormatterOption{compactnumber.Approximately()}, n: 1234000, expectedOut: "約123万"},
+		{localeStr: "en-US", options: []compactnumber.FormatterOption{compactnumber.AtLeast()}, n: 1000, expectedOut: "1K+"},
+		{localeStr: "ru", options: []compactnumber.FormatterOption{compactnumber.AtLeast()}, n: 1000000, expectedOut: "≥1 млн"},
+		{localeStr: "en-US", options: []compactnumber.FormatterOption{compactnumber.Approximately(), compactnumber.AtLeast()}, n: 1000, expectedOut: "1K+"},
+		{localeStr: "en-US", options: []compactnumber.FormatterOption{compactnumber.Cap(99999)}, n: 1500000, expectedOut: "99K+"},
+		{localeStr: "en-US", options: []compactnumber.FormatterOption{compactnumber.Cap(99999)}, n: 99999, expectedOut: "99K"},
+		{localeStr: "fr", options: []compactnumber.FormatterOption{compactnumber.Cap(99999)}, n: 100000, expectedOut: "≥99 k"},
+		{localeStr: "en-US", options: []compactnumber.FormatterOption{compactnumber.Cap(99999), compactnumber.Approximately()}, n: 5500, expectedOut: "~5K"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("%d/%s", i, tt.localeStr), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.localeStr, compactnumber.Short, tt.options...)
+			out, err := formatter.Format(tt.n)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+}
+
+func TestFormatterSignDisplay(t *testing.T) {
+	tests := []struct {
+		localeStr   string
+		compactType compactnumber.CompactType
+		display     compactnumber.SignDisplay
+		n           int
+		expectedOut string
+	}{
+		{localeStr: "en-US", compactType: compactnumber.Short, display: compactnumber.SignAuto, n: 5000, expectedOut: "5K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, display: compactnumber.SignAuto, n: -5000, expectedOut: "-5K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, display: compactnumber.SignAlways, n: 5000, expectedOut: "+5K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, display: compactnumber.SignAlways, n: 0, expectedOut: "+0"},
+		{localeStr: "en-US", compactType: compactnumber.Short, display: compactnumber.SignAlways, n: -5000, expectedOut: "-5K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, display: compactnumber.SignNever, n: -5000, expectedOut: "5K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, display: compactnumber.SignExceptZero, n: 5000, expectedOut: "+5K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, display: compactnumber.SignExceptZero, n: 0, expectedOut: "0"},
+		{localeStr: "en-US", compactType: compactnumber.Short, display: compactnumber.SignAccounting, n: -1200000, expectedOut: "(1M)"},
+		{localeStr: "en-US", compactType: compactnumber.Short, display: compactnumber.SignAccounting, n: 1200000, expectedOut: "1M"},
+		{localeStr: "en-US", compactType: compactnumber.Short, display: compactnumber.SignAccountingAlways, n: 1200000, expectedOut: "+1M"},
+		{localeStr: "en-US", compactType: compactnumber.Long, display: compactnumber.SignAccounting, n: -5000, expectedOut: "(5 thousand)"},
+		{localeStr: "en-US", compactType: compactnumber.None, display: compactnumber.SignAlways, n: 5000, expectedOut: "+5,000"},
+		{localeStr: "en-US", compactType: compactnumber.None, display: compactnumber.SignAccounting, n: -5000, expectedOut: "(5,000)"},
+		// Locales that mark negative amounts with a minus sign keep it in accounting format
+		{localeStr: "de", compactType: compactnumber.Short, display: compactnumber.SignAccounting, n: -5000000, expectedOut: "-5 Mio."},
+		{localeStr: "sw", compactType: compactnumber.Short, display: compactnumber.SignAlways, n: 2499, expectedOut: "elfu +2"},
+		{localeStr: "ar", compactType: compactnumber.Short, display: compactnumber.SignAlways, n: 5000, expectedOut: "\u200e+\u0665\u00a0\u0622\u0644\u0627\u0641"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%s/%d", tt.localeStr, tt.compactType, tt.display, tt.n), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.localeStr, tt.compactType, compactnumber.DisplaySign(tt.display))
+			out, err := formatter.Format(tt.n)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+}
+
+func TestFormatterThreshold(t *testing.T) {
+	tests := []struct {
+		localeStr   string
+		n           int
+		expectedOut string
+	}{
+		{localeStr: "en-US", n: 9999, expectedOut: "9,999"},
+		{localeStr: "en-US", n: -9999, expectedOut: "-9,999"},
+		{localeStr: "en-US", n: 10000, expectedOut: "10K"},
+		{localeStr: "en-US", n: 999, expectedOut: "999"},
+		// Spanish only groups numbers with at least two digits before the first separator
+		{localeStr: "es", n: 9999, expectedOut: "9999"},
+		{localeStr: "es", n: 10000, expectedOut: "10 mil"},
+		{localeStr: "es-MX", n: 9999, expectedOut: "9,999"},
+		// Japanese doesn't compact thousands to begin with
+		{localeStr: "ja", n: 9999, expectedOut: "9,999"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%d", tt.localeStr, tt.n), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.localeStr, compactnumber.Short, compactnumber.Threshold(10000))
+			out, err := formatter.Format(tt.n)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+}
+
+func TestFormatterRound(t *testing.T) {
+	tests := []struct {
+		mode        compactnumber.RoundingMode
+		n           int
+		expectedOut string
+	}{
+		{mode: compactnumber.RoundDown, n: 1999, expectedOut: "1K"},
+		{mode: compactnumber.RoundDown, n: -1999, expectedOut: "-1K"},
+		{mode: compactnumber.RoundUp, n: 1001, expectedOut: "2K"},
+		{mode: compactnumber.RoundUp, n: -1001, expectedOut: "-2K"},
+		{mode: compactnumber.RoundCeiling, n: 1001, expectedOut: "2K"},
+		{mode: compactnumber.RoundCeiling, n: -1999, expectedOut: "-1K"},
+		{mode: compactnumber.RoundFloor, n: 1999, expectedOut: "1K"},
+		{mode: compactnumber.RoundFloor, n: -1001, expectedOut: "-2K"},
+		{mode: compactnumber.RoundHalfEven, n: 1500, expectedOut: "2K"},
+		{mode: compactnumber.RoundHalfEven, n: 2500, expectedOut: "2K"},
+		{mode: compactnumber.RoundHalfEven, n: 2501, expectedOut: "3K"},
+		{mode: compactnumber.RoundHalfDown, n: 2500, expectedOut: "2K"},
+		{mode: compactnumber.RoundHalfDown, n: -2501, expectedOut: "-3K"},
+		{mode: compactnumber.RoundHalfUp, n: 2500, expectedOut: "3K"},
+		{mode: compactnumber.RoundHalfUp, n: 2499, expectedOut: "2K"},
+		// Rounding up to the next type uses its rule
+		{mode: compactnumber.RoundHalfUp, n: 999999, expectedOut: "1M"},
+		{mode: compactnumber.RoundUp, n: -999001, expectedOut: "-1M"},
+		// Numbers that aren't compacted aren't rounded
+		{mode: compactnumber.RoundUp, n: 999, expectedOut: "999"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%d", tt.mode, tt.n), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter("en-US", compactnumber.Short, compactnumber.Round(tt.mode))
+			out, err := formatter.Format(tt.n)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+}
+
+func TestFormatterRoundAtLeast(t *testing.T) {
+	// The at-least pattern must not overstate numbers, so they are truncated whatever the rounding mode
+	formatter := compactnumber.NewFormatter("en-US", compactnumber.Short, compactnumber.Round(compactnumber.RoundHalfUp), compactnumber.Cap(99999))
+	out, err := formatter.Format(150000)
+	mustMatch(t, out, err, "99K+", nil)
+
+	formatter = compactnumber.NewFormatter("en-US", compactnumber.Short, compactnumber.Round(compactnumber.RoundUp), compactnumber.AtLeast())
+	out, err = formatter.Format(1500)
+	mustMatch(t, out, err, "1K+", nil)
+
+	// So are numbers rounded by the number options
+	formatter = compactnumber.NewFormatter("en-US", compactnumber.Short, compactnumber.Cap(99999))
+	out, err = formatter.Format(150000, number.MaxFractionDigits(1))
+	mustMatch(t, out, err, "99.9K+", nil)
+}
+
+func TestFormatterSignificantDigits(t *testing.T) {
+	tests := []struct {
+		localeStr   string
+		max         int
+		mode        compactnumber.RoundingMode
+		n           int
+		expectedOut string
+	}{
+		{localeStr: "en-US", max: 2, mode: compactnumber.RoundHalfUp, n: 1234, expectedOut: "1.2K"},
+		{localeStr: "en-US", max: 3, mode: compactnumber.RoundHalfUp, n: 12345, expectedOut: "12.3K"},
+		{localeStr: "en-US", max: 2, mode: compactnumber.RoundHalfUp, n: 123456, expectedOut: "120K"},
+		{localeStr: "en-US", max: 2, mode: compactnumber.RoundDown, n: -1999, expectedOut: "-1.9K"},
+		// Trailing fraction zeroes are not shown
+		{localeStr: "en-US", max: 3, mode: compactnumber.RoundHalfUp, n: 1000, expectedOut: "1K"},
+		// Rounding up to the next type uses its rule
+		{localeStr: "en-US", max: 2, mode: compactnumber.RoundHalfUp, n: 999999, expectedOut: "1M"},
+		// Numbers formatted in full are rounded too
+		{localeStr: "en-US", max: 2, mode: compactnumber.RoundHalfUp, n: 987, expectedOut: "990"},
+		{localeStr: "ja", max: 2, mode: compactnumber.RoundHalfUp, n: 1234, expectedOut: "1,200"},
+		{localeStr: "de", max: 2, mode: compactnumber.RoundHalfUp, n: 1234567, expectedOut: "1,2\u00a0Mio."},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%d/%s/%d", tt.localeStr, tt.max, tt.mode, tt.n), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.localeStr, compactnumber.Short, compactnumber.Round(tt.mode), compactnumber.SignificantDigits(tt.max))
+			out, err := formatter.Format(tt.n)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+}
+
+func TestFormatterFractionDigits(t *testing.T) {
+	tests := []struct {
+		max         int
+		mode        compactnumber.RoundingMode
+		n           int
+		expectedOut string
+	}{
+		{max: 2, mode: compactnumber.RoundHalfUp, n: 1234, expectedOut: "1.23K"},
+		{max: 1, mode: compactnumber.RoundCeiling, n: 1201, expectedOut: "1.3K"},
+		{max: 1, mode: compactnumber.RoundHalfUp, n: 1500000, expectedOut: "1.5M"},
+		{max: 0, mode: compactnumber.RoundHalfUp, n: 1500, expectedOut: "2K"},
+		// The fraction digits are limited to the divisor's digits
+		{max: 5, mode: compactnumber.RoundHalfUp, n: 1234, expectedOut: "1.234K"},
+		// Numbers formatted in full have no fraction digits
+		{max: 2, mode: compactnumber.RoundHalfUp, n: 999, expectedOut: "999"},
+		// Rounding up to the next type uses its rule
+		{max: 2, mode: compactnumber.RoundHalfUp, n: 999999, expectedOut: "1M"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d/%s/%d", tt.max, tt.mode, tt.n), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter("en-US", compactnumber.Short, compactnumber.Round(tt.mode), compactnumber.FractionDigits(tt.max))
+			out, err := formatter.Format(tt.n)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+}
+
+func TestFormatterPrecision(t *testing.T) {
+	tests := []struct {
+		localeStr   string
+		compactType compactnumber.CompactType
+		options     []compactnumber.FormatterOption
+		n           int
+		expectedOut string
+	}{
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 1234, expectedOut: "1.2K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 12345, expectedOut: "12K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 123456, expectedOut: "123K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: -1250, expectedOut: "-1.2K"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 999, expectedOut: "999"},
+		{localeStr: "en-US", compactType: compactnumber.Short, n: 99950, expectedOut: "100K"},
+		{localeStr: "en-US", compactType: compactnumber.Long, n: 1050000, expectedOut: "1 million"},
+		{localeStr: "de", compactType: compactnumber.Short, n: 1550000, expectedOut: "1,6 Mio."},
+		{localeStr: "ja", compactType: compactnumber.Short, n: 12345, expectedOut: "1.2万"},
+		{localeStr: "en-US", compactType: compactnumber.None, n: 1234, expectedOut: "1,234"},
+		// Round sets the rounding mode instead of half to even
+		{
+			localeStr:   "en-US",
+			compactType: compactnumber.Short,
+			options:     []compactnumber.FormatterOption{compactnumber.Round(compactnumber.RoundDown)},
+			n:           1999,
+			expectedOut: "1.9K",
+		},
+		// The last precision option wins
+		{
+			localeStr:   "en-US",
+			compactType: compactnumber.Short,
+			options:     []compactnumber.FormatterOption{compactnumber.SignificantDigits(3)},
+			n:           1234,
+			expectedOut: "1.23K",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%d", tt.localeStr, tt.compactType, tt.n), func(t *testing.T) {
+			options := append([]compactnumber.FormatterOption{compactnumber.Precision(compactnumber.PrecisionCompact)}, tt.options...)
+			formatter := compactnumber.NewFormatter(tt.localeStr, tt.compactType, options...)
+			out, err := formatter.Format(tt.n)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+		})
+	}
+}
+
+func TestDefaultPrecision(t *testing.T) {
+	defer func(precision compactnumber.PrecisionMode) {
+		compactnumber.DefaultPrecision = precision
+	}(compactnumber.DefaultPrecision)
+	compactnumber.DefaultPrecision = compactnumber.PrecisionCompact
+
+	formatter := compactnumber.NewFormatter("en-US", compactnumber.Short)
+	out, err := formatter.Format(1234)
+	mustMatch(t, out, err, "1.2K", nil)
+
+	// The Precision option overrides the default
+	formatter = compactnumber.NewFormatter("en-US", compactnumber.Short, compactnumber.Precision(compactnumber.PrecisionInteger))
+	out, err = formatter.Format(1234)
+	mustMatch(t, out, err, "1K", nil)
+}
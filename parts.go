@@ -0,0 +1,160 @@
+package compactnumber
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// PartType identifies what a part of a formatted number is.
+type PartType string
+
+const (
+	// The sign of the number, e.g. "-" or "+".
+	PartSign = PartType("sign")
+	// The digits of the integer part of the number, between group separators.
+	PartInteger = PartType("integer")
+	// A group separator, e.g. "," in English.
+	PartGroup = PartType("group")
+	// The decimal separator, e.g. "." in English.
+	PartDecimal = PartType("decimal")
+	// The digits of the fraction part of the number.
+	PartFraction = PartType("fraction")
+	// The compact affix of the number, e.g. "K" or "million" in English, without its surrounding whitespace.
+	PartCompact = PartType("compact")
+	// A currency symbol. Compact decimal patterns have none, so it is reserved for currency formats.
+	PartCurrency = PartType("currency")
+	// Any other text, e.g. whitespace between the number and its compact affix, or the "+" of the at-least pattern.
+	PartLiteral = PartType("literal")
+)
+
+// Part is a segment of a formatted number.
+type Part struct {
+	Type  PartType
+	Value string
+}
+
+// FormatToParts formats an integer like Format, split into typed parts for styling, e.g. 5000 is the integer "5" and
+// the compact "K" in English. Concatenating the values of the parts gives the output of Format.
+func (f *Formatter) FormatToParts(n int, numOptions ...number.Option) ([]Part, error) {
+	parts, _, err := f.formatParts(n, 0, numOptions)
+	return parts, err
+}
+
+// Splits a pattern around its placeholder.
+func splitPattern(pattern string, placeholder string) (string, string) {
+	i := strings.Index(pattern, placeholder)
+	if i == -1 {
+		return pattern, ""
+	}
+
+	return pattern[:i], pattern[i+len(placeholder):]
+}
+
+// Wraps parts in a prefix and a suffix, as literal parts.
+func wrapParts(parts []Part, prefix string, suffix string) []Part {
+	parts = append(literalParts(prefix), parts...)
+	return append(parts, literalParts(suffix)...)
+}
+
+// Splits the affix of a compact pattern into the compact affix and the whitespace around it.
+func compactParts(affix string) []Part {
+	trimmed := strings.TrimSpace(affix)
+	if trimmed == "" {
+		return literalParts(affix)
+	}
+
+	start := strings.Index(affix, trimmed)
+	parts := literalParts(affix[:start])
+	parts = append(parts, Part{Type: PartCompact, Value: trimmed})
+	return append(parts, literalParts(affix[start+len(trimmed):])...)
+}
+
+func literalParts(s string) []Part {
+	if s == "" {
+		return nil
+	}
+
+	return []Part{{Type: PartLiteral, Value: s}}
+}
+
+func signParts(s string) []Part {
+	if s == "" {
+		return nil
+	}
+
+	return []Part{{Type: PartSign, Value: s}}
+}
+
+// Formats a number with golang.org/x/text and splits it into parts. Text before the first digit and after the last is
+// the sign, and text between digits is the decimal separator if it is the locale's, or a group separator otherwise.
+func numberParts(printer *message.Printer, decimal number.Formatter) []Part {
+	s := printer.Sprint(decimal)
+
+	firstDigit := strings.IndexFunc(s, unicode.IsDigit)
+	if firstDigit == -1 {
+		return literalParts(s)
+	}
+	lastDigit := strings.LastIndexFunc(s, unicode.IsDigit)
+	_, size := utf8.DecodeRuneInString(s[lastDigit:])
+	lastDigit += size
+
+	decimalSeparator := decimalSeparator(printer)
+	parts := signParts(s[:firstDigit])
+	digitsType := PartInteger
+	for rest := s[firstDigit:lastDigit]; rest != ""; {
+		// rest always starts with either digits or a separator, and ends with a digit
+		if end := strings.IndexFunc(rest, isNotDigit); end != 0 {
+			if end == -1 {
+				end = len(rest)
+			}
+			parts = append(parts, Part{Type: digitsType, Value: rest[:end]})
+			rest = rest[end:]
+			continue
+		}
+
+		end := strings.IndexFunc(rest, unicode.IsDigit)
+		if separator := rest[:end]; separator == decimalSeparator && digitsType == PartInteger {
+			parts = append(parts, Part{Type: PartDecimal, Value: separator})
+			digitsType = PartFraction
+		} else {
+			parts = append(parts, Part{Type: PartGroup, Value: separator})
+		}
+		rest = rest[end:]
+	}
+
+	return append(parts, signParts(s[lastDigit:])...)
+}
+
+func isNotDigit(r rune) bool {
+	return !unicode.IsDigit(r)
+}
+
+// Finds the decimal separator of the printer's locale by formatting a number with a fraction.
+func decimalSeparator(printer *message.Printer) string {
+	s := printer.Sprint(number.Decimal(1.5, number.MinFractionDigits(1)))
+
+	start := strings.IndexFunc(s, isNotDigit)
+	if start == -1 {
+		return ""
+	}
+	end := start + strings.IndexFunc(s[start:], unicode.IsDigit)
+	if end < start {
+		return ""
+	}
+
+	return s[start:end]
+}
+
+// Joins the values of parts.
+func joinParts(parts []Part) string {
+	var b strings.Builder
+	for _, part := range parts {
+		b.WriteString(part.Value)
+	}
+
+	return b.String()
+}
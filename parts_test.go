@@ -0,0 +1,104 @@
+package compactnumber_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+)
+
+func TestFormatterFormatToParts(t *testing.T) {
+	tests := []struct {
+		localeStr   string
+		compactType compactnumber.CompactType
+		options     []compactnumber.FormatterOption
+		n           int
+		expected    []compactnumber.Part
+	}{
+		{
+			localeStr:   "en-US",
+			compactType: compactnumber.Short,
+			n:           -5000,
+			expected:    []compactnumber.Part{{compactnumber.PartSign, "-"}, {compactnumber.PartInteger, "5"}, {compactnumber.PartCompact, "K"}},
+		},
+		{
+			localeStr:   "en-US",
+			compactType: compactnumber.Long,
+			n:           5000,
+			expected:    []compactnumber.Part{{compactnumber.PartInteger, "5"}, {compactnumber.PartLiteral, " "}, {compactnumber.PartCompact, "thousand"}},
+		},
+		{
+			localeStr:   "sw",
+			compactType: compactnumber.Short,
+			n:           2499,
+			expected:    []compactnumber.Part{{compactnumber.PartCompact, "elfu"}, {compactnumber.PartLiteral, " "}, {compactnumber.PartInteger, "2"}},
+		},
+		{
+			localeStr:   "de",
+			compactType: compactnumber.Short,
+			n:           -1499,
+			expected:    []compactnumber.Part{{compactnumber.PartSign, "-"}, {compactnumber.PartInteger, "1"}, {compactnumber.PartGroup, "."}, {compactnumber.PartInteger, "499"}},
+		},
+		{
+			localeStr:   "ar-SA",
+			compactType: compactnumber.Short,
+			n:           -69540001,
+			expected:    []compactnumber.Part{{compactnumber.PartSign, "‎-"}, {compactnumber.PartInteger, "69"}, {compactnumber.PartLiteral, " "}, {compactnumber.PartCompact, "مليون"}},
+		},
+		{
+			localeStr:   "en-US",
+			compactType: compactnumber.Short,
+			options:     []compactnumber.FormatterOption{compactnumber.DisplaySign(compactnumber.SignAccounting)},
+			n:           -1200000,
+			expected:    []compactnumber.Part{{compactnumber.PartLiteral, "("}, {compactnumber.PartInteger, "1"}, {compactnumber.PartCompact, "M"}, {compactnumber.PartLiteral, ")"}},
+		},
+		{
+			localeStr:   "en-US",
+			compactType: compactnumber.Short,
+			options:     []compactnumber.FormatterOption{compactnumber.Approximately(), compactnumber.DisplaySign(compactnumber.SignAlways)},
+			n:           1000,
+			expected:    []compactnumber.Part{{compactnumber.PartLiteral, "~"}, {compactnumber.PartSign, "+"}, {compactnumber.PartInteger, "1"}, {compactnumber.PartCompact, "K"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%d", tt.localeStr, tt.compactType, tt.n), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.localeStr, tt.compactType, tt.options...)
+			parts, err := formatter.FormatToParts(tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(parts, tt.expected) {
+				t.Errorf("got unexpected parts %q (wanted %q)", parts, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatterFormatToPartsMatchesFormat(t *testing.T) {
+	for _, localeStr := range []string{"en-US", "ar-SA", "de", "fi", "ja", "ru", "sw", "zh-TW"} {
+		for _, compactType := range []compactnumber.CompactType{compactnumber.Short, compactnumber.Long, compactnumber.None} {
+			formatter := compactnumber.NewFormatter(localeStr, compactType)
+			for _, n := range []int{0, 999, -1499, 12345, 1999999, -69540001, 1999999999} {
+				out, err := formatter.Format(n)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				parts, err := formatter.FormatToParts(n)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var values []string
+				for _, part := range parts {
+					values = append(values, part.Value)
+				}
+				if joined := strings.Join(values, ""); joined != out {
+					t.Errorf("%s %s %d: parts %q do not match output %q", localeStr, compactType, n, parts, out)
+				}
+			}
+		}
+	}
+}
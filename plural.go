@@ -0,0 +1,76 @@
+package compactnumber
+
+import (
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/number"
+)
+
+// Result is the output of Format with the plural category of the number shown, for nouns that must agree with it.
+//
+// Result implements plural.Interface from golang.org/x/text/feature/plural, so it can be passed to a
+// golang.org/x/text/message.Printer as the argument of a plural.Selectf message, where it selects its plural
+// category and prints its text:
+//
+//	catalog.Set(language.English, "%v followers", plural.Selectf(1, "",
+//		"=1", "%v follower",
+//		"other", "%v followers",
+//	))
+//
+// English selects "one" for "1K", like for 1, so the example matches exactly one instead.
+type Result struct {
+	Text           string
+	PluralCategory PluralCategory
+	// The number shown, if it is not compacted, for matching "=N" selectors, or -1.
+	n int
+}
+
+// String returns the text of the result.
+func (r Result) String() string {
+	return r.Text
+}
+
+// PluralForm implements plural.Interface. The plural category is the one of the formatter's locale, whatever the tag.
+// Compacted numbers do not match "=N" selectors: 1000 is "1K" in English, which is not exactly one.
+func (r Result) PluralForm(t language.Tag, scale int) (plural.Form, int) {
+	return r.PluralCategory.Form(), r.n
+}
+
+// Form returns the equivalent plural form of golang.org/x/text/feature/plural, or plural.Other if there is none.
+func (c PluralCategory) Form() plural.Form {
+	switch c {
+	case PluralZero:
+		return plural.Zero
+	case PluralOne:
+		return plural.One
+	case PluralTwo:
+		return plural.Two
+	case PluralFew:
+		return plural.Few
+	case PluralMany:
+		return plural.Many
+	default:
+		return plural.Other
+	}
+}
+
+// FormatWithPlural formats an integer like Format, along with the plural category the locale's rules select for the
+// number shown rather than n itself, e.g. "one" for 21000 in Russian, shown as "21 тыс.", which is the category Format
+// selected the compact pattern with.
+func (f *Formatter) FormatWithPlural(n int, numOptions ...number.Option) (Result, error) {
+	parts, num, err := f.formatParts(n, 0, numOptions)
+	if err != nil {
+		return Result{}, err
+	}
+
+	exact := -1
+	if num.pattern == "0" {
+		exact = num.abs().n
+	}
+
+	return Result{
+		Text:           joinParts(parts),
+		PluralCategory: PluralCategory(num.pluralForm),
+		n:              exact,
+	}, nil
+}
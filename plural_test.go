@@ -0,0 +1,84 @@
+package compactnumber_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestFormatterFormatWithPlural(t *testing.T) {
+	tests := []struct {
+		localeStr   string
+		compactType compactnumber.CompactType
+		n           int
+		expected    string
+		category    compactnumber.PluralCategory
+	}{
+		{"ru", compactnumber.Short, 21000, "21 тыс.", compactnumber.PluralOne},
+		{"ru", compactnumber.Short, 5000, "5 тыс.", compactnumber.PluralMany},
+		{"ru", compactnumber.Short, -2000, "-2 тыс.", compactnumber.PluralFew},
+		{"ru", compactnumber.Long, 21000000, "21 миллион", compactnumber.PluralOne},
+		{"en-US", compactnumber.Short, 1, "1", compactnumber.PluralOne},
+		{"en-US", compactnumber.Short, 2, "2", compactnumber.PluralOther},
+		{"en-US", compactnumber.Long, 2000, "2 thousand", compactnumber.PluralOther},
+		{"en-US", compactnumber.None, 1000, "1,000", compactnumber.PluralOther},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s/%d", tt.localeStr, tt.compactType, tt.n), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.localeStr, tt.compactType)
+			result, err := formatter.FormatWithPlural(tt.n)
+			mustMatch(t, result.Text, err, tt.expected, nil)
+			if result.PluralCategory != tt.category {
+				t.Errorf("got unexpected plural category %q (wanted %q)", result.PluralCategory, tt.category)
+			}
+		})
+	}
+}
+
+func TestResultPluralSelect(t *testing.T) {
+	builder := catalog.NewBuilder()
+	err := builder.Set(language.English, "%v followers", plural.Selectf(1, "",
+		"=1", "%v follower",
+		"other", "%v followers",
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = builder.Set(language.Russian, "%v followers", plural.Selectf(1, "",
+		"one", "%v подписчик",
+		"few", "%v подписчика",
+		"other", "%v подписчиков",
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		localeStr string
+		n         int
+		expected  string
+	}{
+		{"en-US", 1, "1 follower"},
+		{"en-US", 1000, "1K followers"},
+		{"ru", 21000, "21 тыс. подписчик"},
+		{"ru", 3000000, "3 млн подписчика"},
+		{"ru", 11, "11 подписчиков"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%d", tt.localeStr, tt.n), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.localeStr, compactnumber.Short)
+			result, err := formatter.FormatWithPlural(tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			printer := message.NewPrinter(language.Make(tt.localeStr), message.Catalog(builder))
+			mustMatch(t, printer.Sprintf("%v followers", result), nil, tt.expected, nil)
+		})
+	}
+}
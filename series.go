@@ -0,0 +1,157 @@
+package compactnumber
+
+import (
+	"math"
+	"strings"
+
+	"github.com/nkall/compactnumber/internal/compact"
+	"github.com/nkall/compactnumber/internal/models"
+	"golang.org/x/text/number"
+)
+
+// maxSeriesFractionDigits is the greatest number of fraction digits FormatSeries shows before it prefers a smaller
+// magnitude.
+const maxSeriesFractionDigits = 1
+
+// Series is a slice of numbers formatted at a common scale.
+type Series struct {
+	// Values holds the formatted numbers, in the order they were given.
+	Values []string
+	// Magnitude is the number every number was divided by, e.g. 1000 for "12K" and "2,100K", or 0 if the numbers are
+	// not compacted.
+	Magnitude int64
+	// FractionDigits is the number of fraction digits every number was formatted with.
+	FractionDigits int
+	// Label is the unit of the magnitude in the locale's long form, e.g. "thousand" in English, for use in a header
+	// such as "in thousands". It is empty if the numbers are not compacted.
+	Label string
+}
+
+// FormatSeries formats numbers at a single magnitude and precision, e.g. for a column of a table or a chart series.
+// It picks the greatest of the locale's magnitudes, up to the one of the largest number, at which every number can
+// be formatted with at most one fraction digit without being shown as zero or the same as a different number.
+// Mantissas are rounded rather than truncated. If there is no such magnitude, the numbers are formatted in full.
+func (f *Formatter) FormatSeries(ns []int, numOptions ...number.Option) (Series, error) {
+	f, compactForms, err := f.compactForms()
+	if err != nil {
+		return Series{}, err
+	}
+
+	maxN := 0
+	for _, n := range ns {
+		if n < 0 {
+			n = -n
+		}
+		if n > maxN {
+			maxN = n
+		}
+	}
+
+	for _, rule := range seriesCandidates(compactForms[f.compactType], int64(maxN)) {
+		for fractionDigits := 0; fractionDigits <= maxSeriesFractionDigits; fractionDigits++ {
+			if !f.distinguishes(ns, rule, fractionDigits) {
+				continue
+			}
+
+			values, err := f.formatSeriesAt(ns, rule.Type, fractionDigits, numOptions)
+			if err != nil {
+				return Series{}, err
+			}
+			if !distinct(ns, values) {
+				continue
+			}
+
+			return Series{
+				Values:         values,
+				Magnitude:      compact.Divisor(rule),
+				FractionDigits: fractionDigits,
+				Label:          seriesLabel(compactForms[Long], rule.Type),
+			}, nil
+		}
+	}
+
+	// Format every number in full, with the same options otherwise
+	uncompacted := *f
+	uncompacted.compactType = None
+	uncompacted.threshold = 0
+
+	values := make([]string, 0, len(ns))
+	for _, n := range ns {
+		out, err := uncompacted.format(n, 0, numOptions)
+		if err != nil {
+			return Series{}, err
+		}
+		values = append(values, out)
+	}
+
+	return Series{Values: values}, nil
+}
+
+// Returns the rules numbers up to maxN can be formatted at, one per divisor, from the greatest divisor to the smallest.
+// Rules whose pattern is "0" don't compact, so they are left out.
+func seriesCandidates(compactForm []models.CompactFormRule, maxN int64) []models.CompactFormRule {
+	var candidates []models.CompactFormRule
+	for i := len(compactForm) - 1; i >= 0; i-- {
+		rule := compactForm[i]
+		if rule.Type > maxN || rule.PatternsByPluralForm["other"] == "0" {
+			continue
+		}
+		if len(candidates) > 0 && compact.Divisor(candidates[len(candidates)-1]) == compact.Divisor(rule) {
+			continue
+		}
+		candidates = append(candidates, rule)
+	}
+
+	return candidates
+}
+
+// Reports whether no non-zero number rounds to zero at the rule and number of fraction digits.
+func (f *Formatter) distinguishes(ns []int, rule models.CompactFormRule, fractionDigits int) bool {
+	// golang.org/x/text rounds half to even
+	scale := math.Pow10(fractionDigits) / float64(compact.Divisor(rule))
+	for _, n := range ns {
+		if n != 0 && math.RoundToEven(math.Abs(float64(n))*scale) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *Formatter) formatSeriesAt(ns []int, magnitude int64, fractionDigits int, numOptions []number.Option) ([]string, error) {
+	numOptions = append(numOptions[:len(numOptions):len(numOptions)], number.MinFractionDigits(fractionDigits), number.MaxFractionDigits(fractionDigits))
+
+	values := make([]string, 0, len(ns))
+	for _, n := range ns {
+		out, err := f.format(n, magnitude, numOptions)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, out)
+	}
+
+	return values, nil
+}
+
+// Reports whether different numbers have different formatted values.
+func distinct(ns []int, values []string) bool {
+	numbersByValue := make(map[string]int, len(values))
+	for i, value := range values {
+		if n, ok := numbersByValue[value]; ok && n != ns[i] {
+			return false
+		}
+		numbersByValue[value] = ns[i]
+	}
+
+	return true
+}
+
+// Gets the unit of the magnitude from the "other" pattern of the long form rule for it.
+func seriesLabel(longForm []models.CompactFormRule, magnitude int64) string {
+	pattern, err := compact.SprintfPattern(patternFor(compact.SelectRule(longForm, magnitude), "other"))
+	if err != nil || pattern == "0" {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.Replace(pattern, "%v", "", 1))
+}
@@ -0,0 +1,70 @@
+package compactnumber_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+)
+
+func TestFormatterFormatSeries(t *testing.T) {
+	tests := []struct {
+		localeStr string
+		ns        []int
+		options   []compactnumber.FormatterOption
+		expected  compactnumber.Series
+	}{
+		{
+			localeStr: "en-US",
+			ns:        []int{12300, 450000, 2100000},
+			expected:  compactnumber.Series{Values: []string{"12K", "450K", "2,100K"}, Magnitude: 1000, Label: "thousand"},
+		},
+		{
+			localeStr: "en-US",
+			ns:        []int{500000, 12000000, 240300000},
+			expected:  compactnumber.Series{Values: []string{"0.5M", "12.0M", "240.3M"}, Magnitude: 1000000, FractionDigits: 1, Label: "million"},
+		},
+		{
+			localeStr: "en-US",
+			ns:        []int{-1200000, 0, 2000000},
+			expected:  compactnumber.Series{Values: []string{"-1M", "0M", "2M"}, Magnitude: 1000000, Label: "million"},
+		},
+		// Too small to compact alongside the largest number
+		{
+			localeStr: "en-US",
+			ns:        []int{1, 2, 2100000},
+			expected:  compactnumber.Series{Values: []string{"1", "2", "2,100,000"}},
+		},
+		// German doesn't compact thousands, so millions are the only option
+		{
+			localeStr: "de",
+			ns:        []int{12300, 450000, 2100000},
+			expected:  compactnumber.Series{Values: []string{"12.300", "450.000", "2.100.000"}},
+		},
+		{
+			localeStr: "ja",
+			ns:        []int{120000, 4500000, 21000000},
+			expected:  compactnumber.Series{Values: []string{"12万", "450万", "2,100万"}, Magnitude: 10000, Label: "万"},
+		},
+		// Numbers above the cap are truncated to it
+		{
+			localeStr: "en-US",
+			ns:        []int{12300, 50000, 150000},
+			options:   []compactnumber.FormatterOption{compactnumber.Cap(99999)},
+			expected:  compactnumber.Series{Values: []string{"12K", "50K", "99K+"}, Magnitude: 1000, Label: "thousand"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%v", tt.localeStr, tt.ns), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter(tt.localeStr, compactnumber.Short, tt.options...)
+			series, err := formatter.FormatSeries(tt.ns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(series, tt.expected) {
+				t.Errorf("got unexpected series %+v (wanted %+v)", series, tt.expected)
+			}
+		})
+	}
+}
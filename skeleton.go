@@ -0,0 +1,174 @@
+package compactnumber
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The settings of the notation, rounding mode and sign stems, by their long form.
+var (
+	notationStems = map[string]CompactType{
+		"notation-simple": None,
+		"compact-short":   Short,
+		"compact-long":    Long,
+	}
+	roundingModeStems = map[string]RoundingMode{
+		"rounding-mode-down":      RoundDown,
+		"rounding-mode-up":        RoundUp,
+		"rounding-mode-ceiling":   RoundCeiling,
+		"rounding-mode-floor":     RoundFloor,
+		"rounding-mode-half-even": RoundHalfEven,
+		"rounding-mode-half-down": RoundHalfDown,
+		"rounding-mode-half-up":   RoundHalfUp,
+	}
+	signDisplayStems = map[string]SignDisplay{
+		"sign-auto":              SignAuto,
+		"sign-always":            SignAlways,
+		"sign-never":             SignNever,
+		"sign-except-zero":       SignExceptZero,
+		"sign-accounting":        SignAccounting,
+		"sign-accounting-always": SignAccountingAlways,
+	}
+)
+
+// The concise forms of stems.
+var conciseStems = map[string]string{
+	"K":   "compact-short",
+	"KK":  "compact-long",
+	".":   "precision-integer",
+	"+!":  "sign-always",
+	"+_":  "sign-never",
+	"+?":  "sign-except-zero",
+	"()":  "sign-accounting",
+	"()!": "sign-accounting-always",
+}
+
+// ParseSkeleton creates a formatter for the locale configured by an ICU number skeleton, such as
+// "compact-short precision-integer rounding-mode-half-up sign-always". Concise stems such as "K" are supported too.
+//
+// Only the stems of settings Format supports are: notation-simple, compact-short and compact-long, precision-integer,
+// maximum significant digits such as "@##", maximum fraction digits such as ".##", the rounding-mode and the sign
+// stems. An error is returned for any other stem, and for several stems of one setting.
+// As in ICU, numbers are rounded half to even if the skeleton has no rounding-mode stem, and compacted numbers show
+// two significant digits below 100 if it has no precision stem, as with PrecisionCompact.
+//
+// See https://unicode-org.github.io/icu/userguide/format_parse/numbers/skeletons.html
+func ParseSkeleton(lang string, skeleton string) (Formatter, error) {
+	compactType, options, err := skeletonOptions(skeleton)
+	if err != nil {
+		return Formatter{}, err
+	}
+
+	return NewFormatter(lang, compactType, options...), nil
+}
+
+// Parses a skeleton into the compaction type and the options of the formatter it configures.
+func skeletonOptions(skeleton string) (CompactType, []FormatterOption, error) {
+	compactType := None
+	options := []FormatterOption{Round(RoundHalfEven), Precision(PrecisionInteger)}
+
+	seen := make(map[string]string)
+	setting := func(name string, stem string) error {
+		if previous, ok := seen[name]; ok {
+			return errors.New(fmt.Sprintf("conflicting skeleton stems %q and %q", previous, stem))
+		}
+		seen[name] = stem
+		return nil
+	}
+
+	for _, stem := range strings.Fields(skeleton) {
+		if long, ok := conciseStems[stem]; ok {
+			stem = long
+		}
+
+		var err error
+		if notation, ok := notationStems[stem]; ok {
+			err = setting("notation", stem)
+			compactType = notation
+		} else if mode, ok := roundingModeStems[stem]; ok {
+			err = setting("rounding-mode", stem)
+			options = append(options, Round(mode))
+		} else if display, ok := signDisplayStems[stem]; ok {
+			err = setting("sign", stem)
+			options = append(options, DisplaySign(display))
+		} else if stem == "precision-integer" {
+			err = setting("precision", stem)
+		} else if max, ok := precisionStem(stem, "@"); ok {
+			err = setting("precision", stem)
+			options = append(options, SignificantDigits(max))
+		} else if max, ok := precisionStem(stem, "."); ok {
+			err = setting("precision", stem)
+			options = append(options, FractionDigits(max))
+		} else {
+			err = errors.New(fmt.Sprintf("unsupported skeleton stem %q", stem))
+		}
+		if err != nil {
+			return None, nil, err
+		}
+	}
+
+	// ICU shows two significant digits of compacted numbers below 100 by default
+	if _, ok := seen["precision"]; !ok && compactType != None {
+		options = append(options, Precision(PrecisionCompact))
+	}
+
+	return compactType, options, nil
+}
+
+// Skeleton returns the ICU number skeleton of the formatter's settings, in long form, e.g.
+// "compact-short precision-integer rounding-mode-down" for a formatter with default options. ParseSkeleton parses it
+// back to an equivalent formatter. The precision stem is left out for PrecisionCompact, ICU's default for compact
+// notations.
+//
+// Options without a skeleton stem, such as Approximately, AtLeast, Cap and Threshold, are not included.
+func (f *Formatter) Skeleton() string {
+	var stems []string
+	for stem, notation := range notationStems {
+		if notation == f.compactType && notation != None {
+			stems = append(stems, stem)
+		}
+	}
+	switch {
+	case f.precise && f.compactRounding && f.compactType != None:
+		// The default precision of compact notations has no stem
+	case f.precise && f.maxSignificantDigits > 0:
+		stems = append(stems, "@"+strings.Repeat("#", f.maxSignificantDigits-1))
+	case f.precise && f.maxFractionDigits > 0:
+		stems = append(stems, "."+strings.Repeat("#", f.maxFractionDigits))
+	default:
+		stems = append(stems, "precision-integer")
+	}
+
+	roundingMode := f.roundingMode
+	if roundingMode == "" {
+		roundingMode = RoundDown
+	}
+	for stem, mode := range roundingModeStems {
+		if mode == roundingMode {
+			stems = append(stems, stem)
+		}
+	}
+
+	for stem, display := range signDisplayStems {
+		if display == f.signDisplay && display != SignAuto {
+			stems = append(stems, stem)
+		}
+	}
+
+	return strings.Join(stems, " ")
+}
+
+// Parses a stem of a maximum number of significant or fraction digits, such as "@##" or ".##": the prefix "@" or "."
+// followed by optional digits "#". The "@" of significant digits counts as one.
+func precisionStem(stem string, prefix string) (int, bool) {
+	optional := strings.TrimPrefix(stem, prefix)
+	if !strings.HasPrefix(stem, prefix) || strings.Trim(optional, "#") != "" {
+		return 0, false
+	}
+
+	if prefix == "@" {
+		return len(optional) + 1, true
+	}
+	return len(optional), optional != ""
+}
@@ -0,0 +1,138 @@
+package compactnumber_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+)
+
+func TestParseSkeleton(t *testing.T) {
+	tests := []struct {
+		skeleton         string
+		n                int
+		expectedOut      string
+		expectedSkeleton string
+	}{
+		{
+			skeleton:         "compact-short precision-integer rounding-mode-half-up sign-always",
+			n:                2500,
+			expectedOut:      "+3K",
+			expectedSkeleton: "compact-short precision-integer rounding-mode-half-up sign-always",
+		},
+		{
+			skeleton:         "compact-long",
+			n:                2500,
+			expectedOut:      "2.5 thousand",
+			expectedSkeleton: "compact-long rounding-mode-half-even",
+		},
+		{
+			skeleton:         "compact-long precision-integer",
+			n:                2500,
+			expectedOut:      "2 thousand",
+			expectedSkeleton: "compact-long precision-integer rounding-mode-half-even",
+		},
+		{
+			skeleton:         "KK . +?",
+			n:                -1500,
+			expectedOut:      "-2 thousand",
+			expectedSkeleton: "compact-long precision-integer rounding-mode-half-even sign-except-zero",
+		},
+		{
+			skeleton:         "K () rounding-mode-down",
+			n:                -1999,
+			expectedOut:      "(1.9K)",
+			expectedSkeleton: "compact-short rounding-mode-down sign-accounting",
+		},
+		{
+			skeleton:         "K @# rounding-mode-half-up",
+			n:                1250,
+			expectedOut:      "1.3K",
+			expectedSkeleton: "compact-short @# rounding-mode-half-up",
+		},
+		{
+			skeleton:         "compact-short .## rounding-mode-ceiling",
+			n:                1234,
+			expectedOut:      "1.24K",
+			expectedSkeleton: "compact-short .## rounding-mode-ceiling",
+		},
+		{
+			skeleton:         "",
+			n:                2500,
+			expectedOut:      "2,500",
+			expectedSkeleton: "precision-integer rounding-mode-half-even",
+		},
+		{
+			skeleton:         "notation-simple sign-never",
+			n:                -2500,
+			expectedOut:      "2,500",
+			expectedSkeleton: "precision-integer rounding-mode-half-even sign-never",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.skeleton, func(t *testing.T) {
+			formatter, err := compactnumber.ParseSkeleton("en-US", tt.skeleton)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			out, err := formatter.Format(tt.n)
+			mustMatch(t, out, err, tt.expectedOut, nil)
+			mustMatch(t, formatter.Skeleton(), nil, tt.expectedSkeleton, nil)
+
+			// The skeleton parses back to the same settings
+			parsed, err := compactnumber.ParseSkeleton("en-US", formatter.Skeleton())
+			if err != nil {
+				t.Fatal(err)
+			}
+			mustMatch(t, parsed.Skeleton(), nil, tt.expectedSkeleton, nil)
+		})
+	}
+}
+
+func TestParseSkeletonErrors(t *testing.T) {
+	tests := []struct {
+		skeleton string
+		err      string
+	}{
+		{"compact-short compact-long", "conflicting skeleton stems \"compact-short\" and \"compact-long\""},
+		{"K sign-always +_", "conflicting skeleton stems \"sign-always\" and \"sign-never\""},
+		{"compact-short precision-increment/5", "unsupported skeleton stem \"precision-increment/5\""},
+		{"K @# .##", "conflicting skeleton stems \"@#\" and \".##\""},
+		{"K @@#", "unsupported skeleton stem \"@@#\""},
+		{"scientific", "unsupported skeleton stem \"scientific\""},
+		{"rounding-mode-unnecessary", "unsupported skeleton stem \"rounding-mode-unnecessary\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.skeleton, func(t *testing.T) {
+			_, err := compactnumber.ParseSkeleton("en-US", tt.skeleton)
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("got unexpected error %v (wanted %s)", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestFormatterSkeleton(t *testing.T) {
+	tests := []struct {
+		compactType compactnumber.CompactType
+		options     []compactnumber.FormatterOption
+		expected    string
+	}{
+		{compactnumber.Short, nil, "compact-short precision-integer rounding-mode-down"},
+		{compactnumber.None, nil, "precision-integer rounding-mode-down"},
+		{
+			compactnumber.Long,
+			[]compactnumber.FormatterOption{compactnumber.Round(compactnumber.RoundCeiling), compactnumber.DisplaySign(compactnumber.SignAccountingAlways)},
+			"compact-long precision-integer rounding-mode-ceiling sign-accounting-always",
+		},
+		// Options without a stem are left out
+		{compactnumber.Short, []compactnumber.FormatterOption{compactnumber.Approximately()}, "compact-short precision-integer rounding-mode-down"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s", tt.compactType, tt.expected), func(t *testing.T) {
+			formatter := compactnumber.NewFormatter("en-US", tt.compactType, tt.options...)
+			mustMatch(t, formatter.Skeleton(), nil, tt.expected, nil)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+//go:build go1.21
+// +build go1.21
+
+package compactnumber
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SlogFallbacks returns a callback for OnFallback that logs every fallback to logger at level, with the attributes of
+// FallbackEvent.LogValue under the "fallback" key. Events below the logger's level are dropped before any attribute is
+// built.
+func SlogFallbacks(logger *slog.Logger, level slog.Level) func(FallbackEvent) {
+	return func(event FallbackEvent) {
+		ctx := context.Background()
+		if !logger.Enabled(ctx, level) {
+			return
+		}
+
+		logger.LogAttrs(ctx, level, "compactnumber fallback", slog.Any("fallback", event))
+	}
+}
+
+// LogValue implements slog.LogValuer, logging the event as a group of its fields. Empty fields are left out.
+func (e FallbackEvent) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("requested", e.Requested.String()),
+		slog.String("locale", e.Locale.String()),
+		slog.String("reason", string(e.Reason)),
+	}
+	if e.Type != 0 {
+		attrs = append(attrs, slog.Int64("type", e.Type))
+	}
+	if e.Category != "" {
+		attrs = append(attrs, slog.String("category", string(e.Category)))
+	}
+
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,41 @@
+//go:build go1.21
+// +build go1.21
+
+package compactnumber_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+)
+
+func TestSlogFallbacks(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	formatter := compactnumber.NewFormatter("tlh", compactnumber.Short,
+		compactnumber.DefaultLocale("de"),
+		compactnumber.OnFallback(compactnumber.SlogFallbacks(logger, slog.LevelWarn)),
+	)
+	if _, err := formatter.Format(1234567); err != nil {
+		t.Fatalf("got unexpected error %v", err)
+	}
+
+	expected := `level=WARN msg="compactnumber fallback" fallback.requested=tlh fallback.locale=de fallback.reason=default-locale`
+	if out := strings.TrimSpace(buf.String()); !strings.HasSuffix(out, expected) {
+		t.Errorf("got unexpected log %s (wanted %s)", out, expected)
+	}
+
+	// Events below the logger's level are dropped
+	buf.Reset()
+	formatter = compactnumber.NewFormatter("tlh", compactnumber.Short,
+		compactnumber.DefaultLocale("de"),
+		compactnumber.OnFallback(compactnumber.SlogFallbacks(logger, slog.LevelDebug)),
+	)
+	if _, err := formatter.Format(1234567); err != nil || buf.Len() != 0 {
+		t.Errorf("got unexpected log %s and error %v", buf.String(), err)
+	}
+}
@@ -0,0 +1,44 @@
+// Records the outputs of Intl.NumberFormat for intl.json, the corpus TestIntlCorpus checks NewIntlFormatter against.
+// Run it with Node.js, whose Intl.NumberFormat is the one of Chrome, from this directory:
+//
+//	node intl.js > intl.json.new && mv intl.json.new intl.json
+//
+// The differences of cases recorded before are kept, and should be reviewed against the new outputs.
+const fs = require('fs');
+
+const locales = ['ar', 'de', 'en-IN', 'en-US', 'es', 'fr', 'hi', 'it', 'ja', 'ko', 'pl', 'pt', 'ru', 'sw', 'tr', 'zh'];
+
+const options = [
+  {notation: 'compact'},
+  {notation: 'compact', compactDisplay: 'long'},
+  {notation: 'compact', maximumSignificantDigits: 2},
+  {notation: 'compact', compactDisplay: 'long', maximumSignificantDigits: 3, roundingMode: 'halfEven'},
+  {notation: 'compact', maximumFractionDigits: 0, roundingMode: 'trunc'},
+  {notation: 'compact', maximumFractionDigits: 2, roundingMode: 'ceil', signDisplay: 'exceptZero'},
+  {notation: 'compact', roundingMode: 'floor', signDisplay: 'always'},
+  {maximumSignificantDigits: 2, signDisplay: 'never'},
+  {},
+];
+
+const numbers = [0, 7, 999, 1234, 1500, 9999, 12345, 99999, 123456, 999999, 1050000, -1999, -2500, 21000, 1234567890, 999999999999];
+
+const previous = fs.existsSync('intl.json') ? JSON.parse(fs.readFileSync('intl.json')) : {differences: {}, cases: []};
+const key = (c) => JSON.stringify([c.locale, c.options, c.n]);
+const differences = new Map(previous.cases.filter((c) => c.difference).map((c) => [key(c), c.difference]));
+
+const cases = [];
+for (const locale of locales) {
+  for (const opts of options) {
+    const format = new Intl.NumberFormat(locale, opts);
+    for (const n of numbers) {
+      const c = {locale, options: opts, n, expected: format.format(n)};
+      if (differences.has(key(c))) {
+        c.difference = differences.get(key(c));
+      }
+      cases.push(c);
+    }
+  }
+}
+
+const source = `Node.js ${process.versions.node} Intl.NumberFormat (V8 ${process.versions.v8}, ICU ${process.versions.icu}, CLDR ${process.versions.cldr})`;
+process.stdout.write(JSON.stringify({source, differences: previous.differences, cases}, null, 2) + '\n');
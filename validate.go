@@ -0,0 +1,98 @@
+package compactnumber
+
+import (
+	"sort"
+
+	"github.com/nkall/compactnumber/internal/compact"
+	"github.com/nkall/compactnumber/internal/models"
+)
+
+// ValidationCheck identifies the check of Validate that produced a finding.
+type ValidationCheck string
+
+const (
+	// Types must strictly increase within a compaction type.
+	CheckTypeOrder = ValidationCheck(compact.CheckTypeOrder)
+	// Types must be powers of ten.
+	CheckTypePowerOfTen = ValidationCheck(compact.CheckTypePowerOfTen)
+	// Every rule must have an "other" pattern.
+	CheckMissingOther = ValidationCheck(compact.CheckMissingOther)
+	// The plural categories of a rule must match the ones the locale's plural rules select.
+	CheckPluralCategories = ValidationCheck(compact.CheckPluralCategories)
+	// ZeroesInPattern must match the number of zeroes in every pattern of the rule.
+	CheckZeroesInPattern = ValidationCheck(compact.CheckZeroesInPattern)
+	// Short and Long must have rules for the same types.
+	CheckMagnitudeCoverage = ValidationCheck(compact.CheckMagnitudeCoverage)
+)
+
+// Severity indicates whether a finding breaks formatting or is merely suspicious.
+type Severity string
+
+const (
+	// Error findings break formatting for some numbers.
+	SeverityError = Severity(compact.Error)
+	// Warning findings indicate data that is likely not what CLDR intended, but still formats.
+	SeverityWarning = Severity(compact.Warning)
+)
+
+// Finding is a single problem found in the compact form rules of a locale. CompactType, Type and PluralForm are
+// empty when the problem is not specific to them.
+type Finding struct {
+	Locale      string
+	CompactType CompactType
+	Type        int64
+	PluralForm  string
+	Check       ValidationCheck
+	Severity    Severity
+	Message     string
+}
+
+// String returns a readable description of the finding, e.g.
+// `de Short 1000: no "other" pattern to fall back to [error missing-other]`.
+func (f Finding) String() string {
+	return toInternalFinding(f).String()
+}
+
+// Validate checks the compact form rules of every supported locale and returns what it finds, ordered by locale.
+// The same checks run in the generator whenever the rules are regenerated.
+func Validate() []Finding {
+	locales := make([]string, 0, len(compactFormsByLanguage))
+	for locale := range compactFormsByLanguage {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	var findings []Finding
+	for _, locale := range locales {
+		forms := make(map[string][]models.CompactFormRule, len(compactFormsByLanguage[locale]))
+		for compactType, rules := range compactFormsByLanguage[locale] {
+			forms[string(compactType)] = rules
+		}
+
+		for _, finding := range compact.Validate(locale, forms) {
+			findings = append(findings, Finding{
+				Locale:      finding.Locale,
+				CompactType: CompactType(finding.CompactType),
+				Type:        finding.Type,
+				PluralForm:  finding.PluralForm,
+				Check:       ValidationCheck(finding.Check),
+				Severity:    Severity(finding.Severity),
+				Message:     finding.Message,
+			})
+		}
+	}
+
+	return findings
+}
+
+func toInternalFinding(f Finding) compact.Finding {
+	return compact.Finding{
+		Locale:      f.Locale,
+		CompactType: string(f.CompactType),
+		Type:        f.Type,
+		PluralForm:  f.PluralForm,
+		Check:       compact.Check(f.Check),
+		Severity:    compact.Severity(f.Severity),
+		Message:     f.Message,
+	}
+}
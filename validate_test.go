@@ -0,0 +1,32 @@
+package compactnumber_test
+
+import (
+	"testing"
+
+	"github.com/nkall/compactnumber"
+)
+
+func TestValidateBuiltInRules(t *testing.T) {
+	for _, finding := range compactnumber.Validate() {
+		if finding.Severity == compactnumber.SeverityError {
+			t.Errorf("got unexpected error finding %s", finding)
+		}
+	}
+}
+
+func TestFindingString(t *testing.T) {
+	finding := compactnumber.Finding{
+		Locale:      "de",
+		CompactType: compactnumber.Short,
+		Type:        1000,
+		PluralForm:  "one",
+		Check:       compactnumber.CheckZeroesInPattern,
+		Severity:    compactnumber.SeverityError,
+		Message:     "pattern \"00 Tsd.\" has 2 zeroes, but ZeroesInPattern is 1",
+	}
+
+	expected := "de Short 1000 one: pattern \"00 Tsd.\" has 2 zeroes, but ZeroesInPattern is 1 [error zeroes-in-pattern]"
+	if out := finding.String(); out != expected {
+		t.Errorf("got unexpected output %s (wanted %s)", out, expected)
+	}
+}
@@ -0,0 +1,83 @@
+package compactnumber
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// Value is an integer to be compacted when printed, in the language of the golang.org/x/text/message.Printer
+// printing it, like the values of golang.org/x/text/number:
+//
+//	p := message.NewPrinter(language.German)
+//	p.Sprintf("%v views", compactnumber.Compact(1200000, compactnumber.Short)) // 1 Mio. views
+//
+// Values also select their plural category in plural.Selectf messages, see Result. Outside of a Printer, they are
+// printed for language.Und, which falls back to English.
+type Value struct {
+	n           int
+	compactType CompactType
+	options     []FormatterOption
+}
+
+// Compact wraps an integer to be printed with the compaction type and formatter options.
+func Compact(n int, compactType CompactType, options ...FormatterOption) Value {
+	return Value{
+		n:           n,
+		compactType: compactType,
+		options:     options,
+	}
+}
+
+// Format implements fmt.Formatter. It supports the verbs v, s and d, and the width and - flag of fmt, which pads the
+// value on the right instead of the left. The width is counted in display columns, like in FormatFit.
+func (v Value) Format(state fmt.State, verb rune) {
+	if !strings.ContainsRune("vsd", verb) {
+		fmt.Fprintf(state, "%%!%c(compactnumber.Value=%d)", verb, v.n)
+		return
+	}
+
+	result, err := v.format(stateLanguage(state))
+	if err != nil {
+		fmt.Fprintf(state, "%%!%c(compactnumber.Value=%d: %s)", verb, v.n, err)
+		return
+	}
+
+	out := result.Text
+	if width, ok := state.Width(); ok {
+		if padding := width - displayWidth(out); padding > 0 {
+			if state.Flag('-') {
+				out += strings.Repeat(" ", padding)
+			} else {
+				out = strings.Repeat(" ", padding) + out
+			}
+		}
+	}
+	fmt.Fprint(state, out)
+}
+
+// PluralForm implements plural.Interface, selecting the plural category of the value as printed in the language.
+func (v Value) PluralForm(t language.Tag, scale int) (plural.Form, int) {
+	result, err := v.format(t)
+	if err != nil {
+		return plural.Other, -1
+	}
+
+	return result.PluralForm(t, scale)
+}
+
+func (v Value) format(lang language.Tag) (Result, error) {
+	formatter := NewFormatter(lang.String(), v.compactType, v.options...)
+	return formatter.FormatWithPlural(v.n)
+}
+
+// Gets the language of a golang.org/x/text/message.Printer printing a value, or language.Und for other printers.
+func stateLanguage(state fmt.State) language.Tag {
+	if s, ok := state.(interface{ Language() language.Tag }); ok {
+		return s.Language()
+	}
+
+	return language.Und
+}
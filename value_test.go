@@ -0,0 +1,84 @@
+package compactnumber_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nkall/compactnumber"
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/message/catalog"
+)
+
+func TestValuePrinter(t *testing.T) {
+	tests := []struct {
+		lang     language.Tag
+		format   string
+		args     []interface{}
+		expected string
+	}{
+		{
+			lang:     language.AmericanEnglish,
+			format:   "%v views",
+			args:     []interface{}{compactnumber.Compact(1200000, compactnumber.Short)},
+			expected: "1M views",
+		},
+		{
+			lang:     language.German,
+			format:   "%v Aufrufe",
+			args:     []interface{}{compactnumber.Compact(1200000, compactnumber.Short)},
+			expected: "1 Mio. Aufrufe",
+		},
+		{
+			lang:     language.Russian,
+			format:   "%d, %s",
+			args:     []interface{}{compactnumber.Compact(-5000, compactnumber.Long), compactnumber.Compact(1000, compactnumber.Short)},
+			expected: "-5 тысяч, 1 тыс.",
+		},
+		{
+			lang:     language.AmericanEnglish,
+			format:   "%v",
+			args:     []interface{}{compactnumber.Compact(120000, compactnumber.Short, compactnumber.Cap(99999))},
+			expected: "99K+",
+		},
+		{
+			lang:     language.AmericanEnglish,
+			format:   "[%5v] [%-5v]",
+			args:     []interface{}{compactnumber.Compact(1000, compactnumber.Short), compactnumber.Compact(1000, compactnumber.Short)},
+			expected: "[   1K] [1K   ]",
+		},
+		{
+			lang:     language.AmericanEnglish,
+			format:   "%x",
+			args:     []interface{}{compactnumber.Compact(1000, compactnumber.Short)},
+			expected: "%!x(compactnumber.Value=1000)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s", tt.lang, tt.format), func(t *testing.T) {
+			printer := message.NewPrinter(tt.lang)
+			mustMatch(t, printer.Sprintf(tt.format, tt.args...), nil, tt.expected, nil)
+		})
+	}
+}
+
+func TestValueFmt(t *testing.T) {
+	mustMatch(t, fmt.Sprint(compactnumber.Compact(5000, compactnumber.Long)), nil, "5 thousand", nil)
+}
+
+func TestValuePluralSelect(t *testing.T) {
+	builder := catalog.NewBuilder()
+	err := builder.Set(language.Russian, "%v views", plural.Selectf(1, "",
+		"one", "%v просмотр",
+		"few", "%v просмотра",
+		"other", "%v просмотров",
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	printer := message.NewPrinter(language.Russian, message.Catalog(builder))
+	out := printer.Sprintf("%v views", compactnumber.Compact(21000, compactnumber.Short))
+	mustMatch(t, out, nil, "21 тыс. просмотр", nil)
+}